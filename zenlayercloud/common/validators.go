@@ -60,3 +60,26 @@ func NonEmptySetFieldValidFunc(keys ...string) schema.CustomizeDiffFunc {
 		return nil
 	}
 }
+
+// GrowOnlyFieldValidFunc rejects decreasing the int field of an existing resource at plan time, such as the size of a disk.
+// The computedKeys, such as the time of the last resize, are marked as known after apply when the field is changed.
+func GrowOnlyFieldValidFunc(key string, computedKeys ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+		if diff.Id() == "" || !diff.HasChange(key) {
+			return nil
+		}
+		for _, k := range computedKeys {
+			if err := diff.SetNewComputed(k); err != nil {
+				return err
+			}
+		}
+		if !diff.NewValueKnown(key) {
+			return nil
+		}
+		o, n := diff.GetChange(key)
+		if n.(int) < o.(int) {
+			return fmt.Errorf("`%s` can only be increased, changing it from %d to %d is not allowed", key, o.(int), n.(int))
+		}
+		return nil
+	}
+}
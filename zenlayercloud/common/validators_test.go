@@ -0,0 +1,71 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestGrowOnlyFieldValidFunc(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"disk_size": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"last_resize_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		CustomizeDiff: GrowOnlyFieldValidFunc("disk_size", "last_resize_time"),
+	}
+	newState := func(id string) *terraform.InstanceState {
+		if id == "" {
+			return nil
+		}
+		return &terraform.InstanceState{
+			ID: id,
+			Attributes: map[string]string{
+				"id":               id,
+				"disk_size":        "40",
+				"last_resize_time": "2024-01-01T00:00:00Z",
+			},
+		}
+	}
+
+	cases := []struct {
+		name      string
+		id        string
+		diskSize  interface{}
+		expectErr bool
+		computed  bool
+	}{
+		{"create", "", 20, false, true},
+		{"unchanged", "disk-1", 40, false, false},
+		{"grow", "disk-1", 60, false, true},
+		{"shrink", "disk-1", 20, true, false},
+		// the unknown value of terraform
+		{"unknown", "disk-1", "74D93920-ED26-11E3-AC10-0800200C9A66", false, true},
+	}
+	for _, c := range cases {
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{"disk_size": c.diskSize})
+		diff, err := r.SimpleDiff(context.Background(), newState(c.id), config, nil)
+		if (err != nil) != c.expectErr {
+			t.Errorf("%s: expect error %v, got %v", c.name, c.expectErr, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		computed := false
+		if diff != nil && diff.Attributes["last_resize_time"] != nil {
+			computed = diff.Attributes["last_resize_time"].NewComputed
+		}
+		if computed != c.computed {
+			t.Errorf("%s: expect last_resize_time computed %v, got %v", c.name, c.computed, computed)
+		}
+	}
+}
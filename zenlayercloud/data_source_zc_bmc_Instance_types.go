@@ -100,6 +100,36 @@ func dataSourceZenlayerCloudInstanceTypes() *schema.Resource {
 							Computed:    true,
 							Description: "Sell status of the instance.",
 						},
+						"support_raids": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+							Description: "The RAID levels supported by the instance type.",
+						},
+						"total_disk_size": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Total disk size of the instance type. Unit: GB.",
+						},
+						"disks": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Disks of the instance type. Disks are numbered sequentially from 1 in this order, which is the `disk_sequence` used by `raid_config_custom` of `zenlayercloud_bmc_instance`.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"disk_size": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "Disk size. Unit: GB.",
+									},
+									"disk_count": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "Quantity of disks of the size.",
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -151,6 +181,29 @@ func dataSourceZenlayerCloudInstanceTypesRead(ctx context.Context, d *schema.Res
 		return diag.FromErr(err)
 	}
 
+	typeIds := make([]string, 0, len(instanceTypes))
+	for _, instanceType := range instanceTypes {
+		if !common.IsContains(typeIds, instanceType.InstanceTypeId) {
+			typeIds = append(typeIds, instanceType.InstanceTypeId)
+		}
+	}
+	var typeInfos []*bmc.InstanceType
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutRead)-time.Minute, func() *resource.RetryError {
+		var e error
+		typeInfos, e = bmcService.DescribeInstanceTypes(ctx, typeIds)
+		if e != nil {
+			return common.RetryError(ctx, e, common.InternalServerError)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	typeInfoMap := make(map[string]*bmc.InstanceType, len(typeInfos))
+	for _, typeInfo := range typeInfos {
+		typeInfoMap[typeInfo.InstanceTypeId] = typeInfo
+	}
+
 	instanceTypeList := make([]map[string]interface{}, 0, len(instanceTypes))
 	ids := make([]string, 0, len(instanceTypes))
 	for _, instanceType := range instanceTypes {
@@ -165,6 +218,20 @@ func dataSourceZenlayerCloudInstanceTypesRead(ctx context.Context, d *schema.Res
 			"default_traffic_package_size": instanceType.DefaultTrafficPackageSize,
 			"sell_status":                  instanceType.SellStatus,
 		}
+		if typeInfo, ok := typeInfoMap[instanceType.InstanceTypeId]; ok {
+			mapping["support_raids"] = typeInfo.SupportRaids
+			if typeInfo.DiskInfo != nil {
+				mapping["total_disk_size"] = typeInfo.DiskInfo.TotalDiskSize
+				disks := make([]map[string]interface{}, 0, len(typeInfo.DiskInfo.Disks))
+				for _, disk := range typeInfo.DiskInfo.Disks {
+					disks = append(disks, map[string]interface{}{
+						"disk_size":  disk.DiskSize,
+						"disk_count": disk.DiskCount,
+					})
+				}
+				mapping["disks"] = disks
+			}
+		}
 
 		instanceTypeList = append(instanceTypeList, mapping)
 		ids = append(ids, instanceType.InstanceTypeId)
@@ -0,0 +1,157 @@
+/*
+Use this data source to query which IPs of a BYOIP CIDR block are allocated to instances.
+
+Example Usage
+
+```hcl
+
+data "zenlayercloud_bmc_byoip_allocations" "foo" {
+  cidr_block_id = zenlayercloud_bmc_byoip.foo.id
+}
+
+output "allocated_ips" {
+  value = data.zenlayercloud_bmc_byoip_allocations.foo.allocated_ips
+}
+
+```
+*/
+package zenlayercloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	common2 "github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/common"
+	"github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/connectivity"
+	bmc2 "github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/bmc20260201"
+	"github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/common"
+)
+
+func dataSourceZenlayerCloudBmcByoipAllocations() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceZenlayerCloudBmcByoipAllocationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"cidr_block_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the BYOIP CIDR block to be queried.",
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of the instance that the IPs are allocated to.",
+			},
+			"ip": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The IP to be queried.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+			// Computed value
+			"allocated_ips": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IPs which are allocated to instances.",
+			},
+			"ips": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "An information list of the IPs of the CIDR block. Each element contains the following attributes:",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IP.",
+						},
+						"instance_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the instance that the IP is allocated to. Empty if the IP is not allocated.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the IP.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceZenlayerCloudBmcByoipAllocationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer common2.LogElapsed(ctx, "data_source.zenlayercloud_bmc_byoip_allocations.read")()
+
+	client := meta.(*connectivity.ZenlayerCloudClient)
+
+	cidrBlockId := d.Get("cidr_block_id").(string)
+	request := bmc2.NewDescribeCidrBlockIpsRequest()
+	request.CidrBlockId = common.String(cidrBlockId)
+	if v, ok := d.GetOk("instance_id"); ok {
+		request.InstanceId = common.String(v.(string))
+	}
+	if v, ok := d.GetOk("ip"); ok {
+		request.Ip = common.String(v.(string))
+	}
+
+	var cidrBlockIps []*bmc2.CidrBlockIp
+	err := resource.RetryContext(ctx, common2.ReadRetryTimeout, func() *resource.RetryError {
+		var errRet error
+		cidrBlockIps, errRet = describeBmcCidrBlockIps(ctx, client, request)
+		if errRet != nil {
+			return common2.RetryError(ctx, errRet, common2.InternalServerError, common2.ReadTimedOut)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ids, allocatedIps, ipList := flattenBmcCidrBlockIps(cidrBlockIps)
+
+	d.SetId(common2.DataResourceIdHash(append([]string{cidrBlockId}, ids...)))
+	_ = d.Set("allocated_ips", allocatedIps)
+	err = d.Set("ips", ipList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if err := common2.WriteToFile(output.(string), ipList); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+// flattenBmcCidrBlockIps returns all the IPs, the IPs allocated to instances and the information list of the IPs.
+func flattenBmcCidrBlockIps(cidrBlockIps []*bmc2.CidrBlockIp) (ips []string, allocatedIps []string, ipList []map[string]interface{}) {
+	ips = make([]string, 0, len(cidrBlockIps))
+	allocatedIps = make([]string, 0)
+	ipList = make([]map[string]interface{}, 0, len(cidrBlockIps))
+	for _, cidrBlockIp := range cidrBlockIps {
+		ip := common.ToString(cidrBlockIp.Ip)
+		instanceId := common.ToString(cidrBlockIp.InstanceId)
+		ips = append(ips, ip)
+		if instanceId != "" {
+			allocatedIps = append(allocatedIps, ip)
+		}
+		ipList = append(ipList, map[string]interface{}{
+			"ip":          ip,
+			"instance_id": instanceId,
+			"status":      common.ToString(cidrBlockIp.Status),
+		})
+	}
+	return
+}
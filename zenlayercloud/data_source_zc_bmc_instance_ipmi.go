@@ -0,0 +1,126 @@
+/*
+Use this data source to query the out-of-band management (IPMI) status of a bmc instance.
+
+~> **NOTE:** The API does not expose the IPMI endpoint address or temporary credentials. The IPMI login password is the `password` of the instance, which is set on creation or reinstall.
+
+Example Usage
+
+```hcl
+
+data "zenlayercloud_bmc_instance_ipmi" "web" {
+  instance_id = "xxxxxxxx"
+}
+
+output "ipmi_reachable" {
+  value = data.zenlayercloud_bmc_instance_ipmi.web.ipmi_reachable
+}
+
+```
+*/
+package zenlayercloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	common2 "github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/common"
+	"github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/connectivity"
+	bmc "github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/bmc20221120"
+)
+
+func dataSourceZenlayerCloudInstanceIpmi() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceZenlayerCloudInstanceIpmiRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the instance to be queried.",
+			},
+			// Computed value
+			"instance_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Current status of the instance.",
+			},
+			"power_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The power state of the instance. Valid values: `on`, `off`. Empty if the instance is in other status, such as installing.",
+			},
+			"ipmi_ping": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "IPMI IP connectivity. OK: ICMP reachable; CRITICAL: ICMP unreachable; UNKNOWN: State detected failed.",
+			},
+			"ipmi_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "IPMI status. OK: ICMP reachable; WARNING: Abnormal state; UNKNOWN: State detected failed.",
+			},
+			"ipmi_reachable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the IPMI of the instance is reachable, that is both `ipmi_ping` and `ipmi_status` are `OK`.",
+			},
+			"server_brand": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Server supplier brand.",
+			},
+			"server_model": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Server supplier model.",
+			},
+		},
+	}
+}
+
+func dataSourceZenlayerCloudInstanceIpmiRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer common2.LogElapsed(ctx, "data_source.zenlayercloud_bmc_instance_ipmi.read")()
+
+	bmcService := BmcService{
+		client: meta.(*connectivity.ZenlayerCloudClient),
+	}
+
+	instanceId := d.Get("instance_id").(string)
+
+	var instance *bmc.InstanceInfo
+	var health *bmc.InstanceHealth
+	err := resource.RetryContext(ctx, common2.ReadRetryTimeout, func() *resource.RetryError {
+		var errRet error
+		instance, errRet = bmcService.DescribeInstanceById(ctx, instanceId)
+		if errRet != nil {
+			return common2.RetryError(ctx, errRet, common2.InternalServerError, common2.ReadTimedOut)
+		}
+		health, errRet = bmcService.DescribeInstanceMonitorHealth(ctx, instanceId)
+		if errRet != nil {
+			return common2.RetryError(ctx, errRet, common2.InternalServerError, common2.ReadTimedOut)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if instance == nil {
+		return diag.Errorf("bmc instance %s not found", instanceId)
+	}
+	if health == nil {
+		return diag.Errorf("instance health status not found for instance: %s", instanceId)
+	}
+
+	d.SetId(instanceId)
+	_ = d.Set("instance_status", instance.InstanceStatus)
+	_ = d.Set("power_state", bmcInstancePowerState(instance.InstanceStatus))
+	_ = d.Set("ipmi_ping", health.IpmiPing)
+	_ = d.Set("ipmi_status", health.IpmiStatus)
+	_ = d.Set("ipmi_reachable", health.IpmiPing == BmcHealthStatusOk && health.IpmiStatus == BmcHealthStatusOk)
+	_ = d.Set("server_brand", health.ServerBrand)
+	_ = d.Set("server_model", health.ServerModel)
+
+	return nil
+}
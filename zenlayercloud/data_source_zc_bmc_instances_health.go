@@ -0,0 +1,277 @@
+/*
+Use this data source to query hardware health status of bmc instances.
+
+~> **NOTE:** An instance is healthy when the CPU, disk and memory status are `OK`, and none of the power supply, fan and IPMI status is `WARNING` or `CRITICAL`. Set `fail_on_unhealthy` to make the read fail when any instance is unhealthy, for example in a fleet check pipeline.
+
+Example Usage
+
+```hcl
+
+data "zenlayercloud_bmc_instances_health" "fleet" {
+  availability_zone = "SEL-A"
+  instance_status   = "RUNNING"
+  fail_on_unhealthy = true
+}
+
+output "unhealthy" {
+  value = data.zenlayercloud_bmc_instances_health.fleet.unhealthy_instance_ids
+}
+
+```
+*/
+package zenlayercloud
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	common2 "github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/common"
+	"github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/connectivity"
+	bmc "github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/bmc20221120"
+	"github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/common"
+)
+
+func dataSourceZenlayerCloudInstancesHealth() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceZenlayerCloudInstancesHealthRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_ids": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "IDs of the instances to be queried.",
+			},
+			"availability_zone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of zone that the bmc instance locates at.",
+			},
+			"instance_type_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Instance type, such as `M6C`.",
+			},
+			"instance_status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Status of the instances to be queried.",
+			},
+			"resource_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of resource group that the instance grouped by.",
+			},
+			"fail_on_unhealthy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicate whether to fail the read when any instance is unhealthy. Default is `false`.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+			// Computed value
+			"unhealthy_instance_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the instances which are unhealthy.",
+			},
+			"health_list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "An information list of instance health status. Each element contains the following attributes:",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the instance.",
+						},
+						"instance_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the instance.",
+						},
+						"healthy": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the instance is healthy.",
+						},
+						"unhealthy_items": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The hardware items which are not healthy, such as `disk_status=WARNING`.",
+						},
+						"cpu_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "CPU status. OK: Normal; WARNING: Abnormal state; UNKNOWN: State detected failed.",
+						},
+						"disk_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Disk status. OK: Normal; WARNING: Abnormal state; UNKNOWN: State detected failed.",
+						},
+						"memory_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Memory status. OK: Normal; WARNING: Abnormal state; UNKNOWN: State detected failed.",
+						},
+						"psu_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Power Supply status. OK: Normal; WARNING: Abnormal state; UNKNOWN: State detected failed.",
+						},
+						"fan_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Fan status. OK: Normal. WARNING: Abnormal state. UNKNOWN: State detected failed.",
+						},
+						"ipmi_ping": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "IPMI IP connectivity. OK: ICMP reachable; CRITICAL: ICMP unreachable; UNKNOWN: State detected failed.",
+						},
+						"ipmi_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "IPMI status. OK: ICMP reachable; WARNING: Abnormal state; UNKNOWN: State detected failed.",
+						},
+						"wan_port_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "WAN port status of the switch connected to the server's public network port.",
+						},
+						"server_brand": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Server supplier brand.",
+						},
+						"server_model": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Server supplier model.",
+						},
+						"inlet_temp": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Temperature of the air or environment surrounding the server equipment.",
+						},
+						"temp_unit": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Temperature unit. Only Celsius is supported, that is Celsius.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceZenlayerCloudInstancesHealthRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer common2.LogElapsed(ctx, "data_source.zenlayercloud_bmc_instances_health.read")()
+
+	bmcService := BmcService{
+		client: meta.(*connectivity.ZenlayerCloudClient),
+	}
+	filter := &InstancesFilter{}
+	if v, ok := d.GetOk("instance_ids"); ok {
+		instanceIds := v.(*schema.Set).List()
+		if len(instanceIds) > 0 {
+			filter.InstancesIds = common2.ToStringList(instanceIds)
+		}
+	}
+	if v, ok := d.GetOk("availability_zone"); ok {
+		filter.ZoneId = common.String(v.(string))
+	}
+	if v, ok := d.GetOk("instance_type_id"); ok {
+		filter.InstanceTypeId = common.String(v.(string))
+	}
+	if v, ok := d.GetOk("instance_status"); ok {
+		filter.InstanceStatus = common.String(v.(string))
+	}
+	if v, ok := d.GetOk("resource_group_id"); ok {
+		filter.ResourceGroupId = common.String(v.(string))
+	}
+
+	instances, err := bmcService.DescribeInstancesByFilter(filter)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	ids := make([]string, 0, len(instances))
+	names := make(map[string]string, len(instances))
+	for _, instance := range instances {
+		ids = append(ids, instance.InstanceId)
+		names[instance.InstanceId] = instance.InstanceName
+	}
+
+	var healthList []*bmc.InstanceHealth
+	err = resource.RetryContext(ctx, common2.ReadRetryTimeout, func() *resource.RetryError {
+		var errRet error
+		healthList, errRet = bmcService.DescribeInstancesMonitorHealth(ctx, ids)
+		if errRet != nil {
+			return common2.RetryError(ctx, errRet, common2.InternalServerError, common2.ReadTimedOut)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	result := make([]map[string]interface{}, 0, len(healthList))
+	unhealthyIds := make([]string, 0)
+	var unhealthyDetails []string
+	for _, health := range healthList {
+		items := bmcInstanceUnhealthyItems(health)
+		if len(items) > 0 {
+			unhealthyIds = append(unhealthyIds, health.InstanceId)
+			unhealthyDetails = append(unhealthyDetails, health.InstanceId+"("+strings.Join(items, ", ")+")")
+		}
+		result = append(result, map[string]interface{}{
+			"instance_id":     health.InstanceId,
+			"instance_name":   names[health.InstanceId],
+			"healthy":         len(items) == 0,
+			"unhealthy_items": items,
+			"cpu_status":      health.CpuStatus,
+			"disk_status":     health.DiskStatus,
+			"memory_status":   health.MemoryStatus,
+			"psu_status":      health.PsuStatus,
+			"fan_status":      health.FanStatus,
+			"ipmi_ping":       health.IpmiPing,
+			"ipmi_status":     health.IpmiStatus,
+			"wan_port_status": health.WanPortStatus,
+			"server_brand":    health.ServerBrand,
+			"server_model":    health.ServerModel,
+			"inlet_temp":      health.InletTemp,
+			"temp_unit":       health.TempUnit,
+		})
+	}
+
+	if d.Get("fail_on_unhealthy").(bool) && len(unhealthyIds) > 0 {
+		return diag.Errorf("%d bmc instances are unhealthy: %s", len(unhealthyIds), strings.Join(unhealthyDetails, "; "))
+	}
+
+	d.SetId(common2.DataResourceIdHash(ids))
+	_ = d.Set("unhealthy_instance_ids", unhealthyIds)
+	err = d.Set("health_list", result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if err := common2.WriteToFile(output.(string), result); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
@@ -16,7 +16,6 @@ import (
 	"context"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/common"
 	"github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/connectivity"
 	sdn "github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/sdn20230830"
@@ -126,12 +125,9 @@ func dataSourceZenlayerCloudSdnCloudRouters() *schema.Resource {
 										Description: "The bandwidth cap of the access point.",
 									},
 									"route_type": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										ForceNew:     true,
-										Default:      ROUTE_TYPE_BGP,
-										ValidateFunc: validation.StringInSlice(ROUTE_TYPES, false),
-										Description:  "Type of the route, and available values include BGP and STATIC. The default value is `BGP`.",
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Type of the route, and available values include BGP and STATIC.",
 									},
 									"bgp_asn": {
 										Type:        schema.TypeInt,
@@ -213,7 +209,7 @@ func dataSourceZenlayerCloudSdnCloudRouters() *schema.Resource {
 }
 
 func dataSourceZenlayerCloudSdnCloudRoutersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defer common.LogElapsed(ctx, "data_source.zenlayercloud_sdn_private_connects.read")()
+	defer common.LogElapsed(ctx, "data_source.zenlayercloud_sdn_cloud_routers.read")()
 	//
 	sdnService := SdnService{
 		client: meta.(*connectivity.ZenlayerCloudClient),
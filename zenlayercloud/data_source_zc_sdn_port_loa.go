@@ -0,0 +1,139 @@
+/*
+Use this data source to download the LOA (Letter of Authorization) of datacenter port.
+
+~> **NOTE:** The LOA is available only after it is issued for the port, i.e. `loa_status` is `AVAILABLE`. Set `wait_for_loa` of `zenlayercloud_sdn_port` to wait for it when the port is created. The document is downloaded from the pre-signed download URL of the port.
+
+Example Usage
+
+```hcl
+resource "zenlayercloud_sdn_port" "foo" {
+  name                 = "my_name"
+  datacenter           = "xxxxx-xxxxx-xxxxx"
+  port_type            = "1G"
+  business_entity_name = "John"
+  wait_for_loa         = true
+}
+
+data "zenlayercloud_sdn_port_loa" "foo" {
+  port_id     = zenlayercloud_sdn_port.foo.id
+  output_path = "${path.module}/loa.pdf"
+}
+```
+*/
+package zenlayercloud
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/common"
+	"github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/connectivity"
+	common2 "github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/common"
+	sdn "github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/sdn20230830"
+)
+
+func dataSourceZenlayerCloudSdnPortLoa() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceZenlayerCloudSdnPortLoaRead,
+		Schema: map[string]*schema.Schema{
+			"port_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the port.",
+			},
+			"output_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Local path to save the LOA document.",
+			},
+			// Computed value
+			"loa_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The LOA state.",
+			},
+			"loa_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The LOA URL address.",
+			},
+			"content_base64": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Base64 encoded content of the LOA document.",
+			},
+			"content_sha256": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-256 checksum of the LOA document, in lowercase hex.",
+			},
+		},
+	}
+}
+
+func dataSourceZenlayerCloudSdnPortLoaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer common.LogElapsed(ctx, "data_source.zenlayercloud_sdn_port_loa.read")()
+
+	sdnService := SdnService{
+		client: meta.(*connectivity.ZenlayerCloudClient),
+	}
+	portId := d.Get("port_id").(string)
+
+	var portInfo *sdn.PortInfo
+	err := resource.RetryContext(ctx, common.ReadRetryTimeout, func() *resource.RetryError {
+		var errRet error
+		portInfo, errRet = sdnService.DescribePortById(ctx, portId)
+		if errRet != nil {
+			return common.RetryError(ctx, errRet, common.InternalServerError, common.ReadTimedOut)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if portInfo == nil {
+		return diag.Errorf("port %s not found", portId)
+	}
+	if portInfo.LoaStatus != SdnLoaStatusAvailable || portInfo.LoaDownloadUrl == "" {
+		return diag.Errorf("LOA of port %s is not available yet, current LOA status: %s", portId, portInfo.LoaStatus)
+	}
+
+	var content []byte
+	err = resource.RetryContext(ctx, common.ReadRetryTimeout, func() *resource.RetryError {
+		var errRet error
+		content, errRet = sdnService.DownloadPortLoa(ctx, portInfo.LoaDownloadUrl)
+		if errRet != nil {
+			return common.RetryError(ctx, errRet, common.InternalServerError, common.ReadTimedOut, common2.NetworkError)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if output, ok := d.GetOk("output_path"); ok && output.(string) != "" {
+		outputPath := output.(string)
+		if err := os.MkdirAll(filepath.Dir(outputPath), 0755); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := os.WriteFile(outputPath, content, 0644); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	checksum := sha256.Sum256(content)
+	d.SetId(portId)
+	_ = d.Set("loa_status", portInfo.LoaStatus)
+	_ = d.Set("loa_url", portInfo.LoaDownloadUrl)
+	_ = d.Set("content_base64", base64.StdEncoding.EncodeToString(content))
+	_ = d.Set("content_sha256", hex.EncodeToString(checksum[:]))
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package zenlayercloud
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	common2 "github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/common"
+	"github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/common"
+)
+
+func TestDownloadPortLoa(t *testing.T) {
+	cases := []struct {
+		status int
+		code   string
+	}{
+		{http.StatusOK, ""},
+		{http.StatusGatewayTimeout, common2.ReadTimedOut},
+		{http.StatusBadGateway, common2.InternalServerError},
+		{http.StatusForbidden, ""},
+	}
+	for _, c := range cases {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "" {
+				t.Errorf("expect no credential in the request of the pre-signed url")
+			}
+			w.WriteHeader(c.status)
+			_, _ = w.Write([]byte("loa"))
+		}))
+		content, err := (&SdnService{}).DownloadPortLoa(context.Background(), server.URL+"/loa.pdf?signature=abc")
+		server.Close()
+
+		if c.status == http.StatusOK {
+			if err != nil || string(content) != "loa" {
+				t.Errorf("status %d: unexpected content %q, error %v", c.status, content, err)
+			}
+			continue
+		}
+		if err == nil {
+			t.Errorf("status %d: expect error", c.status)
+			continue
+		}
+		code := ""
+		if e, ok := err.(*common.ZenlayerCloudSdkError); ok {
+			code = e.Code
+		}
+		if code != c.code {
+			t.Errorf("status %d: expect error code %q, got %q", c.status, c.code, code)
+		}
+	}
+}
@@ -0,0 +1,316 @@
+/*
+Use this data source to query the bandwidth utilization of datacenter port over a time range.
+
+~> **NOTE:** `traffic_percentiles` are calculated from `data_points` with the nearest-rank method, so they can be used to size the bandwidth or alert threshold of the port.
+
+Example Usage
+
+```hcl
+data "zenlayercloud_sdn_port_traffic" "foo" {
+  port_id     = "xxxxxxxx"
+  start_time  = "2024-01-01T00:00:00Z"
+  end_time    = "2024-01-31T00:00:00Z"
+  percentiles = [50, 95, 99]
+}
+
+output "out_p95" {
+  value = [for p in data.zenlayercloud_sdn_port_traffic.foo.traffic_percentiles : p.out if p.percentile == 95][0]
+}
+```
+*/
+package zenlayercloud
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/common"
+	"github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/connectivity"
+	sdn "github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/sdn20230830"
+)
+
+func dataSourceZenlayerCloudSdnPortTraffic() *schema.Resource {
+	s := sdnTrafficSchema()
+	s["port_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "ID of the port to be queried.",
+	}
+	return &schema.Resource{
+		ReadContext: dataSourceZenlayerCloudSdnPortTrafficRead,
+		Schema:      s,
+	}
+}
+
+func dataSourceZenlayerCloudSdnPortTrafficRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer common.LogElapsed(ctx, "data_source.zenlayercloud_sdn_port_traffic.read")()
+
+	sdnService := SdnService{
+		client: meta.(*connectivity.ZenlayerCloudClient),
+	}
+	portId := d.Get("port_id").(string)
+	startTime := d.Get("start_time").(string)
+	endTime := d.Get("end_time").(string)
+
+	var traffic *sdn.DescribePortTrafficResponseParams
+	err := resource.RetryContext(ctx, common.ReadRetryTimeout, func() *resource.RetryError {
+		var errRet error
+		traffic, errRet = sdnService.DescribePortTraffic(ctx, portId, startTime, endTime)
+		if errRet != nil {
+			return common.RetryError(ctx, errRet, common.InternalServerError, common.ReadTimedOut)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if traffic == nil {
+		return diag.Errorf("traffic of port %s is not available", portId)
+	}
+
+	summary := &sdnTrafficSummary{
+		Unit:     traffic.Unit,
+		In95:     traffic.In95,
+		InAvg:    traffic.InAvg,
+		InMax:    traffic.InMax,
+		InMin:    traffic.InMin,
+		InTotal:  traffic.InTotal,
+		Out95:    float64(traffic.Out95),
+		OutAvg:   traffic.OutAvg,
+		OutMax:   traffic.OutMax,
+		OutMin:   traffic.OutMin,
+		DataList: traffic.DataList,
+	}
+	d.SetId(common.DataResourceIdHash([]string{portId, startTime, endTime}))
+	if err = setSdnTraffic(d, summary); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// sdnTrafficSummary holds the traffic statistics of ports and private connects.
+type sdnTrafficSummary struct {
+	Unit     string
+	In95     float64
+	InAvg    int
+	InMax    int
+	InMin    int
+	InTotal  int
+	Out95    float64
+	OutAvg   int
+	OutMax   int
+	OutMin   int
+	DataList []*sdn.TrafficData
+}
+
+func sdnTrafficSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"start_time": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+			Description:  "The start time of the time range. The format is `yyyy-MM-ddTHH:mm:ssZ`.",
+		},
+		"end_time": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+			Description:  "The end time of the time range. The format is `yyyy-MM-ddTHH:mm:ssZ`.",
+		},
+		"percentiles": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeFloat,
+				ValidateFunc: validation.FloatBetween(0, 100),
+			},
+			Description: "Percentiles to be calculated from the data points. Valid range: [0,100]. Default is `[95]`.",
+		},
+		"result_output_file": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Used to save results.",
+		},
+		// Computed value
+		"unit": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Unit of bandwidth values, such as `bps`.",
+		},
+		"in_95": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "The 95th percentile inbound bandwidth reported by the billing system.",
+		},
+		"in_avg": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Average inbound bandwidth.",
+		},
+		"in_max": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Peak inbound bandwidth.",
+		},
+		"in_min": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Minimum inbound bandwidth.",
+		},
+		"in_total": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Total inbound traffic.",
+		},
+		"out_95": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "The 95th percentile outbound bandwidth reported by the billing system.",
+		},
+		"out_avg": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Average outbound bandwidth.",
+		},
+		"out_max": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Peak outbound bandwidth.",
+		},
+		"out_min": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Minimum outbound bandwidth.",
+		},
+		"traffic_percentiles": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Bandwidth percentiles calculated from the data points. Each element contains the following attributes:",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"percentile": {
+						Type:        schema.TypeFloat,
+						Computed:    true,
+						Description: "The percentile.",
+					},
+					"in": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "Inbound bandwidth at the percentile.",
+					},
+					"out": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "Outbound bandwidth at the percentile.",
+					},
+				},
+			},
+		},
+		"data_points": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Bandwidth data points. Each element contains the following attributes:",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"time": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Time of the data point.",
+					},
+					"internet_rx": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "Inbound bandwidth.",
+					},
+					"internet_tx": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "Outbound bandwidth.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func setSdnTraffic(d *schema.ResourceData, traffic *sdnTrafficSummary) error {
+	percentiles := []float64{95}
+	if v, ok := d.GetOk("percentiles"); ok && len(v.([]interface{})) > 0 {
+		percentiles = percentiles[:0]
+		for _, p := range v.([]interface{}) {
+			percentiles = append(percentiles, p.(float64))
+		}
+	}
+
+	inValues := make([]int, 0, len(traffic.DataList))
+	outValues := make([]int, 0, len(traffic.DataList))
+	dataPoints := make([]map[string]interface{}, 0, len(traffic.DataList))
+	for _, data := range traffic.DataList {
+		inValues = append(inValues, data.InternetRX)
+		outValues = append(outValues, data.InternetTX)
+		dataPoints = append(dataPoints, map[string]interface{}{
+			"time":        data.Time,
+			"internet_rx": data.InternetRX,
+			"internet_tx": data.InternetTX,
+		})
+	}
+	sort.Ints(inValues)
+	sort.Ints(outValues)
+
+	trafficPercentiles := make([]map[string]interface{}, 0, len(percentiles))
+	for _, p := range percentiles {
+		trafficPercentiles = append(trafficPercentiles, map[string]interface{}{
+			"percentile": p,
+			"in":         trafficPercentile(inValues, p),
+			"out":        trafficPercentile(outValues, p),
+		})
+	}
+
+	result := map[string]interface{}{
+		"unit":                traffic.Unit,
+		"in_95":               traffic.In95,
+		"in_avg":              traffic.InAvg,
+		"in_max":              traffic.InMax,
+		"in_min":              traffic.InMin,
+		"in_total":            traffic.InTotal,
+		"out_95":              traffic.Out95,
+		"out_avg":             traffic.OutAvg,
+		"out_max":             traffic.OutMax,
+		"out_min":             traffic.OutMin,
+		"traffic_percentiles": trafficPercentiles,
+		"data_points":         dataPoints,
+	}
+	for k, v := range result {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if err := common.WriteToFile(output.(string), result); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// trafficPercentile returns the percentile of the sorted values with the nearest-rank method.
+func trafficPercentile(sorted []int, percentile float64) int {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(percentile / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	if rank > len(sorted) {
+		rank = len(sorted)
+	}
+	return sorted[rank-1]
+}
@@ -0,0 +1,25 @@
+package zenlayercloud
+
+import "testing"
+
+func TestTrafficPercentile(t *testing.T) {
+	values := []int{10, 20, 30, 40, 50, 60, 70, 80, 90, 100}
+	cases := []struct {
+		percentile float64
+		expect     int
+	}{
+		{0, 10},
+		{50, 50},
+		{95, 100},
+		{90, 90},
+		{100, 100},
+	}
+	for _, c := range cases {
+		if got := trafficPercentile(values, c.percentile); got != c.expect {
+			t.Errorf("percentile %v: expect %d, got %d", c.percentile, c.expect, got)
+		}
+	}
+	if got := trafficPercentile(nil, 95); got != 0 {
+		t.Errorf("expect 0 for empty values, got %d", got)
+	}
+}
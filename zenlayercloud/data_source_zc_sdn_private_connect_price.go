@@ -0,0 +1,326 @@
+/*
+Use this data source to inquire the price of layer 2 private connect before it is created or its bandwidth is changed.
+
+~> **NOTE:** If any endpoint connects to a cloud, `connect_bandwidth` must be one of the bandwidths offered by the cloud access point, which are exported as `available_bandwidths`.
+
+~> **NOTE:** `price` is the price of creating the private connect, while `bandwidth_price` is the price of `connect_bandwidth` between the datacenters of the endpoints, which applies to changing the bandwidth of an existing private connect. Failures of querying `available_bandwidths` or `bandwidth_price` are reported as warnings.
+
+Example Usage
+
+```hcl
+data "zenlayercloud_sdn_private_connect_price" "foo" {
+  connect_bandwidth = 50
+  endpoints {
+    port_id       = "xxxxxxxxx"
+    endpoint_type = "PORT"
+    vlan_id       = "1019"
+  }
+  endpoints {
+    datacenter    = "SOF1"
+    cloud_region  = "eu-west-1"
+    cloud_account = "123412341234"
+    endpoint_type = "AWS"
+    vlan_id       = "1457"
+  }
+}
+
+output "price" {
+  value = data.zenlayercloud_sdn_private_connect_price.foo.price
+}
+```
+*/
+package zenlayercloud
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/common"
+	"github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/connectivity"
+	common2 "github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/common"
+	sdn "github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/sdn20230830"
+)
+
+func dataSourceZenlayerCloudSdnPrivateConnectPrice() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceZenlayerCloudSdnPrivateConnectPriceRead,
+		Schema: map[string]*schema.Schema{
+			"connect_bandwidth": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 500),
+				Description:  "The bandwidth of private connect. Valid range: [1,500]. Unit: Mbps.",
+			},
+			"endpoints": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    2,
+				MaxItems:    2,
+				Description: "Access points of private connect. Length must be equal to 2.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"endpoint_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(ENDPOINT_TYPES, false),
+							Description:  "The type of the access point, Valid values: PORT,AWS,TENCENT and GOOGLE.",
+						},
+						"port_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The ID of the port. This value is required when `endpoint_type` is `PORT`.",
+						},
+						"datacenter": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The ID of data center.",
+						},
+						"cloud_region": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Region of cloud access point. This value is available only when `endpoint_type` within cloud type (AWS, GOOGLE and TENCENT).",
+						},
+						"cloud_account": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The account of public cloud access point. If cloud type is GOOGLE, the value is google pairing key. This value is available only when `endpoint_type` within cloud type (AWS, GOOGLE and TENCENT).",
+						},
+						"vlan_id": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "VLAN ID of the access point. Value range: from 1 to 4096.",
+						},
+					},
+				},
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+			// Computed value
+			"available_bandwidths": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The bandwidths offered by the cloud endpoints. Unit: Mbps. Empty if none of the endpoints connects to a cloud.",
+			},
+			"bandwidth_stock": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The bandwidth available between the datacenters of the endpoints. Unit: Mbps. `0` if it can not be queried.",
+			},
+			"bandwidth_price": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The price of `connect_bandwidth` between the datacenters of the endpoints, which applies to changing the bandwidth of an existing private connect. Empty if it can not be queried. Each element contains the same attributes as `price`.",
+				Elem:        &schema.Resource{Schema: sdnPriceSchema()},
+			},
+			"price": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The price of creating the private connect. Each element contains the following attributes:",
+				Elem:        &schema.Resource{Schema: sdnPriceSchema()},
+			},
+		},
+	}
+}
+
+func sdnPriceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"original_price": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "The original price of prepaid mode.",
+		},
+		"discount_price": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "The discount price of prepaid mode.",
+		},
+		"discount": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "The discount.",
+		},
+		"unit_price": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "The original unit price of postpaid mode.",
+		},
+		"discount_unit_price": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "The discount unit price of postpaid mode.",
+		},
+		"charge_unit": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The charge unit of postpaid mode. Valid values: `HOUR`, `DAY`, `MONTH`.",
+		},
+		"excess_unit_price": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "The original unit price of the excess usage.",
+		},
+		"excess_discount_unit_price": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "The discount unit price of the excess usage.",
+		},
+		"excess_amount_unit": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The unit of the excess usage.",
+		},
+		"step_prices": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The tiered prices of postpaid mode.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"step_start": {
+						Type:        schema.TypeFloat,
+						Computed:    true,
+						Description: "The start of the tier.",
+					},
+					"step_end": {
+						Type:        schema.TypeFloat,
+						Computed:    true,
+						Description: "The end of the tier.",
+					},
+					"unit_price": {
+						Type:        schema.TypeFloat,
+						Computed:    true,
+						Description: "The original unit price of the tier.",
+					},
+					"discount_unit_price": {
+						Type:        schema.TypeFloat,
+						Computed:    true,
+						Description: "The discount unit price of the tier.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceZenlayerCloudSdnPrivateConnectPriceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer common.LogElapsed(ctx, "data_source.zenlayercloud_sdn_private_connect_price.read")()
+
+	sdnService := SdnService{
+		client: meta.(*connectivity.ZenlayerCloudClient),
+	}
+
+	bandwidth := d.Get("connect_bandwidth").(int)
+	endpoints := d.Get("endpoints").([]interface{})
+	endpointA := parseEndpoint(endpoints[0])
+	endpointZ := parseEndpoint(endpoints[1])
+
+	var diags diag.Diagnostics
+
+	tiers, err := describePrivateConnectBandwidthTiers(ctx, &sdnService, []sdn.CreateEndpointParam{endpointA, endpointZ})
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Fail to query available bandwidths of private connect",
+			Detail:   err.Error(),
+		})
+	} else if err = checkPrivateConnectBandwidth(bandwidth, tiers); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var (
+		bandwidthStock int
+		bandwidthPrice []interface{}
+	)
+	priceInfo, err := describePrivateConnectBandwidthPrice(ctx, &sdnService, []sdn.CreateEndpointParam{endpointA, endpointZ}, bandwidth)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Fail to query bandwidth price of private connect",
+			Detail:   err.Error(),
+		})
+	} else if priceInfo != nil {
+		bandwidthStock = priceInfo.Stock
+		if priceInfo.Price != nil {
+			bandwidthPrice = flattenSdnPrice(priceInfo.Price)
+		}
+	}
+
+	var price *sdn.Price
+	err = resource.RetryContext(ctx, common.ReadRetryTimeout, func() *resource.RetryError {
+		var errRet error
+		price, errRet = sdnService.InquiryCreatePrivateConnectPrice(ctx, endpointA, endpointZ, bandwidth)
+		if errRet != nil {
+			return common.RetryError(ctx, errRet, common.InternalServerError, common2.NetworkError)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if price == nil {
+		return diag.Errorf("price of private connect is not available")
+	}
+
+	priceList := flattenSdnPrice(price)
+	d.SetId(common.DataResourceIdHash([]string{
+		strconv.Itoa(bandwidth),
+		fmt.Sprintf("%+v", endpointA),
+		fmt.Sprintf("%+v", endpointZ),
+	}))
+	_ = d.Set("available_bandwidths", tiers)
+	_ = d.Set("bandwidth_stock", bandwidthStock)
+	_ = d.Set("bandwidth_price", bandwidthPrice)
+	err = d.Set("price", priceList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if err := common.WriteToFile(output.(string), priceList); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func flattenSdnPrice(price *sdn.Price) []interface{} {
+	m := map[string]interface{}{
+		"original_price":             floatValue(price.OriginalPrice),
+		"discount_price":             floatValue(price.DiscountPrice),
+		"discount":                   floatValue(price.Discount),
+		"unit_price":                 floatValue(price.UnitPrice),
+		"discount_unit_price":        floatValue(price.DiscountUnitPrice),
+		"charge_unit":                price.ChargeUnit,
+		"excess_unit_price":          floatValue(price.ExcessUnitPrice),
+		"excess_discount_unit_price": floatValue(price.ExcessDiscountUnitPrice),
+		"excess_amount_unit":         price.ExcessAmountUnit,
+	}
+	stepPrices := make([]interface{}, 0, len(price.StepPrices))
+	for _, step := range price.StepPrices {
+		stepPrices = append(stepPrices, map[string]interface{}{
+			"step_start":          floatValue(step.StepStart),
+			"step_end":            floatValue(step.StepEnd),
+			"unit_price":          floatValue(step.UnitPrice),
+			"discount_unit_price": floatValue(step.DiscountUnitPrice),
+		})
+	}
+	m["step_prices"] = stepPrices
+	return []interface{}{m}
+}
+
+func floatValue(v *float64) float64 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
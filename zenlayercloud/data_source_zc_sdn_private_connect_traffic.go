@@ -0,0 +1,93 @@
+/*
+Use this data source to query the bandwidth utilization of layer 2 private connect over a time range.
+
+~> **NOTE:** `traffic_percentiles` are calculated from `data_points` with the nearest-rank method, so they can be used to right-size `connect_bandwidth` of the private connect.
+
+Example Usage
+
+```hcl
+data "zenlayercloud_sdn_private_connect_traffic" "foo" {
+  connect_id  = "xxxxxxxx"
+  start_time  = "2024-01-01T00:00:00Z"
+  end_time    = "2024-01-31T00:00:00Z"
+  percentiles = [95, 99]
+}
+
+locals {
+  p99 = data.zenlayercloud_sdn_private_connect_traffic.foo.traffic_percentiles[1]
+  // Unit of bandwidth is bps
+  peak_mbps = ceil(max(local.p99.in, local.p99.out) / 1000000)
+}
+```
+*/
+package zenlayercloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/common"
+	"github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/connectivity"
+	sdn "github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/sdn20230830"
+)
+
+func dataSourceZenlayerCloudSdnPrivateConnectTraffic() *schema.Resource {
+	s := sdnTrafficSchema()
+	s["connect_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "ID of the private connect to be queried.",
+	}
+	return &schema.Resource{
+		ReadContext: dataSourceZenlayerCloudSdnPrivateConnectTrafficRead,
+		Schema:      s,
+	}
+}
+
+func dataSourceZenlayerCloudSdnPrivateConnectTrafficRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer common.LogElapsed(ctx, "data_source.zenlayercloud_sdn_private_connect_traffic.read")()
+
+	sdnService := SdnService{
+		client: meta.(*connectivity.ZenlayerCloudClient),
+	}
+	connectId := d.Get("connect_id").(string)
+	startTime := d.Get("start_time").(string)
+	endTime := d.Get("end_time").(string)
+
+	var traffic *sdn.DescribePrivateConnectTrafficResponseParams
+	err := resource.RetryContext(ctx, common.ReadRetryTimeout, func() *resource.RetryError {
+		var errRet error
+		traffic, errRet = sdnService.DescribePrivateConnectTraffic(ctx, connectId, startTime, endTime)
+		if errRet != nil {
+			return common.RetryError(ctx, errRet, common.InternalServerError, common.ReadTimedOut)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if traffic == nil {
+		return diag.Errorf("traffic of private connect %s is not available", connectId)
+	}
+
+	summary := &sdnTrafficSummary{
+		Unit:     traffic.Unit,
+		In95:     floatValue(traffic.In95),
+		InAvg:    traffic.InAvg,
+		InMax:    traffic.InMax,
+		InMin:    traffic.InMin,
+		InTotal:  traffic.InTotal,
+		Out95:    float64(traffic.Out95),
+		OutAvg:   traffic.OutAvg,
+		OutMax:   traffic.OutMax,
+		OutMin:   traffic.OutMin,
+		DataList: traffic.DataList,
+	}
+	d.SetId(common.DataResourceIdHash([]string{connectId, startTime, endTime}))
+	if err = setSdnTraffic(d, summary); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -67,6 +67,11 @@ func dataSourceZenlayerCloudSdnPrivateConnects() *schema.Resource {
 										Computed:    true,
 										Description: "The ID of the port.",
 									},
+									"endpoint_id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The ID of the access point.",
+									},
 									"endpoint_name": {
 										Type:        schema.TypeString,
 										Computed:    true,
@@ -214,6 +219,7 @@ func mappingConnectEndpoint(endpoint *sdn.PrivateConnectEndpoint) interface{} {
 	m["cloud_region"] = endpoint.CloudRegionId
 	m["cloud_account"] = endpoint.CloudAccountId
 
+	m["endpoint_id"] = endpoint.EndpointId
 	m["endpoint_name"] = endpoint.EndpointName
 	m["vlan_id"] = endpoint.VlanId
 	m["endpoint_type"] = endpoint.EndpointType
@@ -223,5 +229,6 @@ func mappingConnectEndpoint(endpoint *sdn.PrivateConnectEndpoint) interface{} {
 }
 
 type PrivateConnectFilter struct {
-	ConnectIds []string
+	ConnectIds    []string
+	EndpointTypes []string
 }
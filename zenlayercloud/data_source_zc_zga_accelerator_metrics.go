@@ -0,0 +1,365 @@
+/*
+Use this data source to query the traffic and quality metrics of an accelerator.
+
+~> **NOTE:** Traffic is reported per accelerate region, while request count and latency metrics are only available for the whole accelerator.
+
+Example Usage
+
+```hcl
+
+data "zenlayercloud_zga_accelerator_metrics" "default" {
+  accelerator_id = "<acceleratorId>"
+  start_time     = "2024-01-01T00:00:00Z"
+  end_time       = "2024-01-08T00:00:00Z"
+}
+
+output "peak_out" {
+  value = { for r in data.zenlayercloud_zga_accelerator_metrics.default.region_traffic : r.accelerate_region_id => r.out_max }
+}
+
+```
+*/
+package zenlayercloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/common"
+	"github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/connectivity"
+	zga "github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/zga20230706"
+)
+
+func dataSourceZenlayerCloudZgaAcceleratorMetrics() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceZenlayerCloudZgaAcceleratorMetricsRead,
+		Schema: map[string]*schema.Schema{
+			"accelerator_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the accelerator to be queried.",
+			},
+			"accelerate_region_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the accelerate regions to be queried. If not set, all accelerate regions of the accelerator are queried.",
+			},
+			"listener": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Listener of the accelerator to filter traffic, such as `tcp:80`.",
+			},
+			"start_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "The start time of the time range. The format is `yyyy-MM-ddTHH:mm:ssZ`.",
+			},
+			"end_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "The end time of the time range. The format is `yyyy-MM-ddTHH:mm:ssZ`.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+			// Computed value
+			"region_traffic": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Traffic of each accelerate region. Each element contains the following attributes:",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"accelerate_region_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the accelerate region.",
+						},
+						"bandwidth": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Configured bandwidth of the accelerate region. Unit: Mbps.",
+						},
+						"unit": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Unit of bandwidth values.",
+						},
+						"total_unit": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Unit of traffic total values.",
+						},
+						"in_max": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Peak inbound bandwidth.",
+						},
+						"in_min": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Minimum inbound bandwidth.",
+						},
+						"in_total": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Total inbound traffic.",
+						},
+						"out_max": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Peak outbound bandwidth.",
+						},
+						"out_min": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Minimum outbound bandwidth.",
+						},
+						"out_total": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Total outbound traffic.",
+						},
+						"data_points": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Bandwidth data points.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"time": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Time of the data point.",
+									},
+									"internet_rx": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "Inbound bandwidth.",
+									},
+									"internet_tx": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "Outbound bandwidth.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"request_metrics": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Request count and latency metrics of the accelerator. Each element contains the following attributes:",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"speed_unit": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Unit of download speed.",
+						},
+						"time_unit": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Unit of latency values.",
+						},
+						"request_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Total request count in the time range.",
+						},
+						"data_points": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Metric data points.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"time": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Time of the data point.",
+									},
+									"request_count": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "Request count.",
+									},
+									"average_download_speed": {
+										Type:        schema.TypeFloat,
+										Computed:    true,
+										Description: "Average download speed.",
+									},
+									"average_first_byte_time": {
+										Type:        schema.TypeFloat,
+										Computed:    true,
+										Description: "Average time to first byte.",
+									},
+									"average_ssl_handshake_time": {
+										Type:        schema.TypeFloat,
+										Computed:    true,
+										Description: "Average SSL handshake time.",
+									},
+									"average_request_time": {
+										Type:        schema.TypeFloat,
+										Computed:    true,
+										Description: "Average request time.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceZenlayerCloudZgaAcceleratorMetricsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer common.LogElapsed(ctx, "data_source.zenlayercloud_zga_accelerator_metrics.read")()
+
+	zgaService := NewZgaService(meta.(*connectivity.ZenlayerCloudClient))
+	acceleratorId := d.Get("accelerator_id").(string)
+	listener := d.Get("listener").(string)
+	startTime := d.Get("start_time").(string)
+	endTime := d.Get("end_time").(string)
+
+	var acceleratorInfo *zga.AcceleratorInfo
+	err := resource.RetryContext(ctx, common.ReadRetryTimeout, func() *resource.RetryError {
+		var errRet error
+		acceleratorInfo, errRet = zgaService.DescribeAcceleratorById(ctx, acceleratorId)
+		if errRet != nil {
+			return common.RetryError(ctx, errRet, common.InternalServerError, common.ReadTimedOut)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if acceleratorInfo == nil {
+		return diag.Errorf("accelerator %s not found", acceleratorId)
+	}
+
+	bandwidths := make(map[string]int, len(acceleratorInfo.AccelerateRegions))
+	var regionIds []string
+	for _, region := range acceleratorInfo.AccelerateRegions {
+		bandwidths[region.AccelerateRegionId] = region.Bandwidth
+		regionIds = append(regionIds, region.AccelerateRegionId)
+	}
+	if v, ok := d.GetOk("accelerate_region_ids"); ok {
+		regionIds = common.ToStringList(v.(*schema.Set).List())
+	}
+
+	regionTraffic := make([]map[string]interface{}, 0, len(regionIds))
+	for _, regionId := range regionIds {
+		request := zga.NewDescribeAcceleratorTrafficRequest()
+		request.AcceleratorId = acceleratorId
+		request.AccelerateRegionId = regionId
+		request.Listener = listener
+		request.StartTime = startTime
+		request.EndTime = endTime
+
+		var traffic *zga.DescribeAcceleratorTrafficResponseParams
+		err = resource.RetryContext(ctx, common.ReadRetryTimeout, func() *resource.RetryError {
+			var errRet error
+			traffic, errRet = zgaService.DescribeAcceleratorTraffic(ctx, request)
+			if errRet != nil {
+				return common.RetryError(ctx, errRet, common.InternalServerError, common.ReadTimedOut)
+			}
+			return nil
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		dataPoints := make([]map[string]interface{}, 0, len(traffic.DataList))
+		for _, data := range traffic.DataList {
+			dataPoints = append(dataPoints, map[string]interface{}{
+				"time":        data.Time,
+				"internet_rx": data.InternetRX,
+				"internet_tx": data.InternetTX,
+			})
+		}
+		regionTraffic = append(regionTraffic, map[string]interface{}{
+			"accelerate_region_id": regionId,
+			"bandwidth":            bandwidths[regionId],
+			"unit":                 traffic.Unit,
+			"total_unit":           traffic.TotalUnit,
+			"in_max":               traffic.InMax,
+			"in_min":               traffic.InMin,
+			"in_total":             traffic.InTotal,
+			"out_max":              traffic.OutMax,
+			"out_min":              traffic.OutMin,
+			"out_total":            traffic.OutTotal,
+			"data_points":          dataPoints,
+		})
+	}
+
+	metricsRequest := zga.NewDescribeAcceleratorMetricsRequest()
+	metricsRequest.AcceleratorId = acceleratorId
+	metricsRequest.StartTime = startTime
+	metricsRequest.EndTime = endTime
+
+	var metrics *zga.DescribeAcceleratorMetricsResponseParams
+	err = resource.RetryContext(ctx, common.ReadRetryTimeout, func() *resource.RetryError {
+		var errRet error
+		metrics, errRet = zgaService.DescribeAcceleratorMetrics(ctx, metricsRequest)
+		if errRet != nil {
+			return common.RetryError(ctx, errRet, common.InternalServerError, common.ReadTimedOut)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var requestCount int64
+	metricPoints := make([]map[string]interface{}, 0, len(metrics.DataList))
+	for _, data := range metrics.DataList {
+		requestCount += data.RequestCount
+		metricPoints = append(metricPoints, map[string]interface{}{
+			"time":                       data.Time,
+			"request_count":              data.RequestCount,
+			"average_download_speed":     data.AverageDownloadSpeed,
+			"average_first_byte_time":    data.AverageFirstByteTime,
+			"average_ssl_handshake_time": data.AverageSslHandshakeTime,
+			"average_request_time":       data.AverageRequestTime,
+		})
+	}
+	requestMetrics := []map[string]interface{}{
+		{
+			"speed_unit":    metrics.SpeedUnit,
+			"time_unit":     metrics.TimeUnit,
+			"request_count": requestCount,
+			"data_points":   metricPoints,
+		},
+	}
+
+	d.SetId(common.DataResourceIdHash(append([]string{acceleratorId, listener, startTime, endTime}, regionIds...)))
+	err = d.Set("region_traffic", regionTraffic)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("request_metrics", requestMetrics)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if err := common.WriteToFile(output.(string), map[string]interface{}{
+			"region_traffic":  regionTraffic,
+			"request_metrics": requestMetrics,
+		}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
@@ -1,6 +1,11 @@
 package zenlayercloud
 
-import "github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/common"
+import (
+	"fmt"
+
+	"github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/common"
+	bmc "github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/bmc20221120"
+)
 
 const (
 	ResourceTypeInstance = "instance"
@@ -61,6 +66,17 @@ const (
 	BmcEipStatusRecycle       = "RECYCLE"
 	BmcEipStatusRecycling     = "RECYCLING"
 
+	BmcHealthStatusOk       = "OK"
+	BmcHealthStatusWarning  = "WARNING"
+	BmcHealthStatusCritical = "CRITICAL"
+	BmcHealthStatusUnknown  = "UNKNOWN"
+
+	BmcInstanceHealthHealthy   = "HEALTHY"
+	BmcInstanceHealthUnhealthy = "UNHEALTHY"
+
+	BmcInstancePowerStateOn  = "on"
+	BmcInstancePowerStateOff = "off"
+
 	ImageTypePublic = "PUBLIC_IMAGE"
 	ImageTypeCustom = "CUSTOM_IMAGE"
 )
@@ -89,6 +105,24 @@ var (
 		BmcInternetChargeTypeInstanceBandwidth95,
 		BmcInternetChargeTypeClusterBandwidth95,
 	}
+	BmcInstancePowerStates = []string{
+		BmcInstancePowerStateOn,
+		BmcInstancePowerStateOff,
+	}
+	// BmcInstanceReinstallFields are the fields of bmc instance which are applied by reinstall.
+	BmcInstanceReinstallFields = []string{
+		"hostname",
+		"password",
+		"ssh_keys",
+		"key_id",
+		"image_id",
+		"partitions",
+		"raid_config_type",
+		"raid_config_custom",
+		"nic_wan_name",
+		"nic_lan_name",
+		"user_data",
+	}
 	InstanceOperatingStatus = []string{
 		BmcInstanceStatusPending,
 		BmcInstanceStatusStopping,
@@ -119,6 +153,53 @@ func instanceIsOperating(instanceStatus string) bool {
 	return common.IsContains(InstanceOperatingStatus, instanceStatus)
 }
 
+// bmcInstancePowerState returns the power state of the instance status,
+// or empty if the instance is neither running nor stopped.
+func bmcInstancePowerState(instanceStatus string) string {
+	switch instanceStatus {
+	case BmcInstanceStatusRunning:
+		return BmcInstancePowerStateOn
+	case BMC_INSTANCE_STATUS_STOPPED:
+		return BmcInstancePowerStateOff
+	}
+	return ""
+}
+
 func subnetIsOperating(subnetStatus string) bool {
 	return common.IsContains(SubnetOperatingStatus, subnetStatus)
 }
+
+// bmcInstanceUnhealthyItems returns the hardware items which are not healthy.
+// CPU, disk and memory must be OK, while the other items must not be WARNING or CRITICAL,
+// as they are not detectable on some server models.
+func bmcInstanceUnhealthyItems(health *bmc.InstanceHealth) []string {
+	var items []string
+	required := []struct {
+		name   string
+		status string
+	}{
+		{"cpu_status", health.CpuStatus},
+		{"disk_status", health.DiskStatus},
+		{"memory_status", health.MemoryStatus},
+	}
+	for _, item := range required {
+		if item.status != BmcHealthStatusOk {
+			items = append(items, fmt.Sprintf("%s=%s", item.name, item.status))
+		}
+	}
+	optional := []struct {
+		name   string
+		status string
+	}{
+		{"psu_status", health.PsuStatus},
+		{"fan_status", health.FanStatus},
+		{"ipmi_status", health.IpmiStatus},
+		{"ipmi_ping", health.IpmiPing},
+	}
+	for _, item := range optional {
+		if item.status == BmcHealthStatusWarning || item.status == BmcHealthStatusCritical {
+			items = append(items, fmt.Sprintf("%s=%s", item.name, item.status))
+		}
+	}
+	return items
+}
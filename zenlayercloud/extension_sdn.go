@@ -12,6 +12,15 @@ const (
 	SdnStatusReleasing  = "DESTROYING"
 )
 
+const (
+	SdnLoaStatusAvailable = "AVAILABLE"
+)
+
+const (
+	SdnConnectivityActive = "ACTIVE"
+	SdnConnectivityDown   = "DOWN"
+)
+
 var (
 	SdnOperatingStatus = []string{
 		SdnStatusCreating,
@@ -18,4 +18,8 @@ const (
 	ZgaHTTPSL7Protocol = "https"
 
 	ZgaAccessControlAllListener = "all"
+
+	ZgaManagedExternallyListeners          = "listeners"
+	ZgaManagedExternallyAccessControlRules = "access_control_rules"
+	ZgaManagedExternallyCertificate        = "certificate"
 )
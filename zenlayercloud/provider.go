@@ -55,10 +55,13 @@ Bare Metal Cloud(BMC)
     zenlayercloud_bmc_images
 	zenlayercloud_bmc_instances
 	zenlayercloud_bmc_instance_health_status
+	zenlayercloud_bmc_instances_health
+	zenlayercloud_bmc_instance_ipmi
 	zenlayercloud_bmc_eips
 	zenlayercloud_bmc_vpc_regions
 	zenlayercloud_bmc_vpcs
 	zenlayercloud_bmc_subnets
+	zenlayercloud_bmc_byoip_allocations
 
   Resource
 	zenlayercloud_bmc_instance
@@ -74,9 +77,16 @@ Cloud Networking(SDN)
 	zenlayercloud_sdn_ports
 	zenlayercloud_sdn_private_connects
 	zenlayercloud_sdn_cloud_regions
+	zenlayercloud_sdn_cloud_routers
+	zenlayercloud_sdn_port_loa
+	zenlayercloud_sdn_private_connect_price
+	zenlayercloud_sdn_port_traffic
+	zenlayercloud_sdn_private_connect_traffic
   Resource
 	zenlayercloud_sdn_port
 	zenlayercloud_sdn_private_connect
+	zenlayercloud_sdn_cloud_router
+	zenlayercloud_sdn_cloud_router_edge_point
 
 Zenlayer Global Accelerator(ZGA)
 
@@ -85,10 +95,15 @@ Zenlayer Global Accelerator(ZGA)
 	zenlayercloud_zga_origin_regions
 	zenlayercloud_zga_accelerate_regions
 	zenlayercloud_zga_accelerators
+	zenlayercloud_zga_accelerator_metrics
 
   Resource
 	zenlayercloud_zga_certificate
 	zenlayercloud_zga_accelerator
+	zenlayercloud_zga_listener
+	zenlayercloud_zga_access_control_rule
+	zenlayercloud_zga_health_check
+	zenlayercloud_zga_certificate_binding
 
 Zenlayer Elastic Compute(ZEC)
 
@@ -116,6 +131,7 @@ Zenlayer Elastic Compute(ZEC)
 	zenlayercloud_zec_qos_policy_groups
 	zenlayercloud_zec_havips
 	zenlayercloud_zec_ddos_policies
+	zenlayercloud_ddos_attack_events
 
   Resource
 	zenlayercloud_zec_vpc
@@ -160,6 +176,7 @@ Zenlayer Load Balancing(ZLB)
 	zenlayercloud_zlb_instances
 	zenlayercloud_zlb_listeners
 	zenlayercloud_zlb_backends
+	zenlayercloud_zlb_listener_metrics
 
   Resource
 	zenlayercloud_zlb_instance
@@ -171,11 +188,15 @@ Zenlayer Private DNS(ZDNS)
   Data Source
 	zenlayercloud_zdns_zones
 	zenlayercloud_zdns_zone_records
+	zenlayercloud_zdns_zone_file
 
   Resource
 	zenlayercloud_zdns_zone
 	zenlayercloud_zdns_zone_record
+	zenlayercloud_zdns_record_set
+	zenlayercloud_zdns_zone_file
 	zenlayercloud_zdns_zone_vpc_set_attachment
+	zenlayercloud_zdns_instance_registration
 
 Traffic
 
@@ -304,10 +325,16 @@ func resourcesMap() map[string]*schema.Resource {
 		// cloud networking product
 		"zenlayercloud_sdn_port":            resourceZenlayerCloudDcPorts(),
 		"zenlayercloud_sdn_private_connect": resourceZenlayerCloudPrivateConnect(),
+		"zenlayercloud_sdn_cloud_router":    resourceZenlayerCloudSdnCloudRouter(),
+		"zenlayercloud_sdn_cloud_router_edge_point": resourceZenlayerCloudSdnCloudRouterEdgePoint(),
 
 		// zenlayer global accelerator
 		"zenlayercloud_zga_certificate": resourceZenlayerCloudCertificate(),
 		"zenlayercloud_zga_accelerator": resourceZenlayerCloudAccelerator(),
+		"zenlayercloud_zga_listener":            resourceZenlayerCloudZgaListener(),
+		"zenlayercloud_zga_access_control_rule": resourceZenlayerCloudZgaAccessControlRule(),
+		"zenlayercloud_zga_health_check":        resourceZenlayerCloudZgaHealthCheck(),
+		"zenlayercloud_zga_certificate_binding": resourceZenlayerCloudZgaCertificateBinding(),
 
 		// zenlayer zec product
 		"zenlayercloud_zec_vpc":                           zec.ResourceZenlayerCloudGlobalVpc(),
@@ -361,7 +388,10 @@ func resourcesMap() map[string]*schema.Resource {
 		// Private DNS
 		"zenlayercloud_zdns_zone":                    zdns.ResourceZenlayerCloudPvtdnsZone(),
 		"zenlayercloud_zdns_zone_record":             zdns.ResourceZenlayerCloudPvtdnsRecord(),
+		"zenlayercloud_zdns_record_set":              zdns.ResourceZenlayerCloudPvtdnsRecordSet(),
+		"zenlayercloud_zdns_zone_file":               zdns.ResourceZenlayerCloudPvtdnsZoneFile(),
 		"zenlayercloud_zdns_zone_vpc_set_attachment": zdns.ResourceZenlayerCloudPvtdnsZoneVpcAttachment(),
+		"zenlayercloud_zdns_instance_registration":   zdns.ResourceZenlayerCloudPvtdnsInstanceRegistration(),
 
 	}
 }
@@ -374,10 +404,13 @@ func dataSourcesMap() map[string]*schema.Resource {
 		"zenlayercloud_bmc_images":                 dataSourceZenlayerCloudImages(),
 		"zenlayercloud_bmc_instances":              dataSourceZenlayerCloudInstances(),
 		"zenlayercloud_bmc_instance_health_status": dataSourceZenlayerCloudInstanceHealthStatus(),
+		"zenlayercloud_bmc_instances_health":       dataSourceZenlayerCloudInstancesHealth(),
+		"zenlayercloud_bmc_instance_ipmi":          dataSourceZenlayerCloudInstanceIpmi(),
 		"zenlayercloud_bmc_eips":                   dataSourceZenlayerCloudEips(),
 		"zenlayercloud_bmc_vpc_regions":            dataSourceZenlayerCloudVpcRegions(),
 		"zenlayercloud_bmc_vpcs":                   dataSourceZenlayerCloudVpcs(),
 		"zenlayercloud_bmc_subnets":                dataSourceZenlayerCloudVpcSubnets(),
+		"zenlayercloud_bmc_byoip_allocations":      dataSourceZenlayerCloudBmcByoipAllocations(),
 
 		// vm product
 		"zenlayercloud_security_groups": dataSourceZenlayerCloudSecurityGroups(),
@@ -397,7 +430,11 @@ func dataSourcesMap() map[string]*schema.Resource {
 		"zenlayercloud_sdn_datacenters":      dataSourceZenlayerCloudSdnDatacenters(),
 		"zenlayercloud_sdn_ports":            dataSourceZenlayerCloudDcPorts(),
 		"zenlayercloud_sdn_private_connects": dataSourceZenlayerCloudSdnPrivateConnects(),
-		//"zenlayercloud_sdn_cloud_routers":    dataSourceZenlayerCloudSdnCloudRouters(),
+		"zenlayercloud_sdn_cloud_routers":    dataSourceZenlayerCloudSdnCloudRouters(),
+		"zenlayercloud_sdn_port_loa":        dataSourceZenlayerCloudSdnPortLoa(),
+		"zenlayercloud_sdn_private_connect_price": dataSourceZenlayerCloudSdnPrivateConnectPrice(),
+		"zenlayercloud_sdn_port_traffic":            dataSourceZenlayerCloudSdnPortTraffic(),
+		"zenlayercloud_sdn_private_connect_traffic": dataSourceZenlayerCloudSdnPrivateConnectTraffic(),
 		"zenlayercloud_sdn_cloud_regions": 		dataSourceZenlayerCloudCloudRegions(),
 
 		// zenlayer global accelerator
@@ -405,6 +442,7 @@ func dataSourcesMap() map[string]*schema.Resource {
 		"zenlayercloud_zga_origin_regions":     dataSourceZenlayerCloudZgaOriginRegions(),
 		"zenlayercloud_zga_accelerate_regions": dataSourceZenlayerCloudZgaAccelerateRegions(),
 		"zenlayercloud_zga_accelerators":       dataSourceZenlayerCloudZgaAccelerators(),
+		"zenlayercloud_zga_accelerator_metrics": dataSourceZenlayerCloudZgaAcceleratorMetrics(),
 
 		// zenlayer zec product
 		"zenlayercloud_zec_images":          zec.DataSourceZenlayerCloudZecImages(),
@@ -430,12 +468,14 @@ func dataSourcesMap() map[string]*schema.Resource {
 		"zenlayercloud_zec_vm_inventory_capacities": zec.DataSourceZenlayerCloudZecVmInventoryCapacities(),
 		"zenlayercloud_zec_havips":                   zec.DataSourceZenlayerCloudZecHaVips(),
 		"zenlayercloud_zec_ddos_policies":            zec.DataSourceZenlayerCloudZecDDoSPolicies(),
+		"zenlayercloud_ddos_attack_events":           zec.DataSourceZenlayerCloudDDoSAttackEvents(),
 
 		// zenlayer load balancer
 		"zenlayercloud_zlb_regions":   zlb.DataSourceZenlayerCloudZlbRegions(),
 		"zenlayercloud_zlb_instances": zlb.DataSourceZenlayerCloudZlbInstances(),
 		"zenlayercloud_zlb_listeners": zlb.DataSourceZenlayerCloudZlbListeners(),
 		"zenlayercloud_zlb_backends":  zlb.DataSourceZenlayerCloudZlbBackends(),
+		"zenlayercloud_zlb_listener_metrics": zlb.DataSourceZenlayerCloudZlbListenerMetrics(),
 
 		// zenlayer traffic
 		"zenlayercloud_traffic_bandwidth_cluster_areas": traffic.DataSourceZenlayerCloudTrafficBandwidthClusterAreas(),
@@ -447,6 +487,7 @@ func dataSourcesMap() map[string]*schema.Resource {
 		// Private DNS
 		"zenlayercloud_zdns_zones":        zdns.DataSourceZenlayerCloudPvtdnsZones(),
 		"zenlayercloud_zdns_zone_records": zdns.DataSourceZenlayerCloudPvtdnsRecords(),
+		"zenlayercloud_zdns_zone_file":    zdns.DataSourceZenlayerCloudPvtdnsZoneFile(),
 	}
 }
 
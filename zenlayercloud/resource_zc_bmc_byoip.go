@@ -1,6 +1,10 @@
 /*
 Provide a resource to create a BYOIP (Bring Your Own IP) in BMC.
 
+~> **NOTE:** The CIDR block stays in `CREATING` status until the platform accepts the announcement of the CIDR block, and becomes `FAILED` if it is rejected. `available` is `true` once the CIDR block is `AVAILABLE`. Set `wait_for_available` to `false` to return right after the request is submitted.
+
+~> **NOTE:** The API does not expose the ROA/IRR check results and does not support controlling the BGP announcement, so they are not managed by this resource. Use `zenlayercloud_bmc_byoip_allocations` to list the IPs of the CIDR block allocated to instances.
+
 Example Usage
 
 ```hcl
@@ -9,6 +13,7 @@ resource "zenlayercloud_bmc_byoip" "foo" {
   cidr                        = "203.0.113.0/24"
   asn                         = 65001
   public_virtual_interface_id = "xxxxxxxx"
+  wait_for_available          = true
 }
 ```
 
@@ -42,6 +47,7 @@ func resourceZenlayerCloudBmcByoip() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceZenlayerCloudBmcByoipCreate,
 		ReadContext:   resourceZenlayerCloudBmcByoipRead,
+		UpdateContext: resourceZenlayerCloudBmcByoipUpdate,
 		DeleteContext: resourceZenlayerCloudBmcByoipDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -77,6 +83,33 @@ func resourceZenlayerCloudBmcByoip() *schema.Resource {
 				ForceNew:    true,
 				Description: "The unique ID of the public virtual interface (public VLAN).",
 			},
+			"wait_for_available": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Indicate whether to wait for the CIDR block to be available on creation. Default is `true`.",
+			},
+			"available": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the status of the CIDR block is `AVAILABLE`. It only reflects the status of the CIDR block, not the result of ROA/IRR verification.",
+			},
+			"instance_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the instances bound to the CIDR block.",
+			},
+			"available_ip_start": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The first available IP of the CIDR block.",
+			},
+			"available_ip_end": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The last available IP of the CIDR block.",
+			},
 			"cidr_block_name": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -181,6 +214,10 @@ func resourceZenlayerCloudBmcByoipCreate(ctx context.Context, d *schema.Resource
 
 	d.SetId(cidrBlockId)
 
+	if !d.Get("wait_for_available").(bool) {
+		return resourceZenlayerCloudBmcByoipRead(ctx, d, meta)
+	}
+
 	stateConf := &resource.StateChangeConf{
 		Pending:        []string{BmcCidrBlockStatusCreating},
 		Target:         []string{BmcCidrBlockStatusAvailable},
@@ -227,6 +264,12 @@ func resourceZenlayerCloudBmcByoipRead(ctx context.Context, d *schema.ResourceDa
 		return nil
 	}
 
+	setBmcCidrBlockState(d, cidrBlock)
+
+	return nil
+}
+
+func setBmcCidrBlockState(d *schema.ResourceData, cidrBlock *bmc2.CidrBlockInfo) {
 	_ = d.Set("cidr", cidrBlock.CidrBlock)
 	_ = d.Set("cidr_block_name", cidrBlock.CidrBlockName)
 	_ = d.Set("cidr_block_type", cidrBlock.CidrBlockType)
@@ -234,13 +277,32 @@ func resourceZenlayerCloudBmcByoipRead(ctx context.Context, d *schema.ResourceDa
 	_ = d.Set("gateway", cidrBlock.Gateway)
 	_ = d.Set("available_ip_count", cidrBlock.AvailableIpCount)
 	_ = d.Set("status", cidrBlock.Status)
+	_ = d.Set("available", common.ToString(cidrBlock.Status) == BmcCidrBlockStatusAvailable)
+	_ = d.Set("instance_ids", cidrBlock.InstanceIds)
+	_ = d.Set("available_ip_start", cidrBlock.AvailableIpStart)
+	_ = d.Set("available_ip_end", cidrBlock.AvailableIpEnd)
 	_ = d.Set("charge_type", cidrBlock.ChargeType)
 	_ = d.Set("resource_group_id", cidrBlock.ResourceGroupId)
 	_ = d.Set("resource_group_name", cidrBlock.ResourceGroupName)
 	_ = d.Set("create_time", cidrBlock.CreateTime)
 	_ = d.Set("expire_time", cidrBlock.ExpireTime)
+}
 
-	return nil
+func resourceZenlayerCloudBmcByoipUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// only `wait_for_available` is updatable, which takes effect on creation
+	return resourceZenlayerCloudBmcByoipRead(ctx, d, meta)
+}
+
+func describeBmcCidrBlockIps(ctx context.Context, client *connectivity.ZenlayerCloudClient, request *bmc2.DescribeCidrBlockIpsRequest) ([]*bmc2.CidrBlockIp, error) {
+	response, err := client.WithBmc2Client().DescribeCidrBlockIps(request)
+	defer common2.LogApiRequest(ctx, "DescribeCidrBlockIps", request, response, err)
+	if err != nil {
+		return nil, err
+	}
+	if response.Response == nil {
+		return nil, nil
+	}
+	return response.Response.CidrBlockIps, nil
 }
 
 func describeBmcCidrBlockById(ctx context.Context, client *connectivity.ZenlayerCloudClient, cidrBlockId string) (*bmc2.CidrBlockInfo, error) {
@@ -0,0 +1,74 @@
+package zenlayercloud
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	bmc2 "github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/bmc20260201"
+	"github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/common"
+)
+
+func TestSetBmcCidrBlockState(t *testing.T) {
+	cases := []struct {
+		status    string
+		available bool
+	}{
+		{BmcCidrBlockStatusCreating, false},
+		{BmcCidrBlockStatusAvailable, true},
+		{BmcCidrBlockStatusFailed, false},
+	}
+	for _, c := range cases {
+		d := schema.TestResourceDataRaw(t, resourceZenlayerCloudBmcByoip().Schema, map[string]interface{}{})
+		setBmcCidrBlockState(d, &bmc2.CidrBlockInfo{
+			Status:           common.String(c.status),
+			InstanceIds:      []string{"instance-1", "instance-2"},
+			AvailableIpStart: common.String("203.0.113.2"),
+			AvailableIpEnd:   common.String("203.0.113.254"),
+		})
+		if d.Get("available").(bool) != c.available {
+			t.Errorf("%s: expect available %v, got %v", c.status, c.available, d.Get("available"))
+		}
+		if ids := d.Get("instance_ids").([]interface{}); !reflect.DeepEqual(ids, []interface{}{"instance-1", "instance-2"}) {
+			t.Errorf("%s: unexpected instance_ids: %v", c.status, ids)
+		}
+		if d.Get("available_ip_start").(string) != "203.0.113.2" || d.Get("available_ip_end").(string) != "203.0.113.254" {
+			t.Errorf("%s: unexpected available ip range: %s - %s", c.status, d.Get("available_ip_start"), d.Get("available_ip_end"))
+		}
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceZenlayerCloudBmcByoip().Schema, map[string]interface{}{})
+	if !d.Get("wait_for_available").(bool) {
+		t.Errorf("expect wait_for_available to be true by default")
+	}
+}
+
+func TestFlattenBmcCidrBlockIps(t *testing.T) {
+	ips, allocatedIps, ipList := flattenBmcCidrBlockIps([]*bmc2.CidrBlockIp{
+		{Ip: common.String("203.0.113.2"), InstanceId: common.String("instance-1"), Status: common.String("USED")},
+		{Ip: common.String("203.0.113.3"), Status: common.String("AVAILABLE")},
+	})
+	if !reflect.DeepEqual(ips, []string{"203.0.113.2", "203.0.113.3"}) {
+		t.Errorf("unexpected ips: %v", ips)
+	}
+	if !reflect.DeepEqual(allocatedIps, []string{"203.0.113.2"}) {
+		t.Errorf("unexpected allocated ips: %v", allocatedIps)
+	}
+	expect := []map[string]interface{}{
+		{"ip": "203.0.113.2", "instance_id": "instance-1", "status": "USED"},
+		{"ip": "203.0.113.3", "instance_id": "", "status": "AVAILABLE"},
+	}
+	if !reflect.DeepEqual(ipList, expect) {
+		t.Errorf("unexpected ip list: %v", ipList)
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceZenlayerCloudBmcByoipAllocations().Schema, map[string]interface{}{
+		"cidr_block_id": "cidr-1",
+	})
+	if err := d.Set("ips", ipList); err != nil {
+		t.Errorf("fail to set ips: %v", err)
+	}
+	if _, _, ipList = flattenBmcCidrBlockIps(nil); len(ipList) != 0 {
+		t.Errorf("expect empty ip list, got %v", ipList)
+	}
+}
@@ -3,6 +3,12 @@ Provides a BMC instance resource.
 
 ~> **NOTE:** You can launch an BMC instance for a private network via specifying parameter `subnet_id`.
 
+~> **NOTE:** `raid_config_type`, `raid_config_custom` and `partitions` are validated at plan time against the disks of the instance type, which are exported by `zenlayercloud_bmc_instance_types`.
+
+~> **NOTE:** Changing `image_id`, `hostname`, `password`, `ssh_keys`, `key_id`, `partitions`, RAID config, nic names or `user_data` reinstalls the instance in place, and `user_data` is applied again by the reinstall. Set `reinstall` to `false` to recreate the instance instead when any of these fields is changed.
+
+~> **NOTE:** `power_state` powers the instance on or off, and changing `reboot_trigger` reboots it, so that recovery runbooks can be codified. Use `zenlayercloud_bmc_instance_ipmi` to check the out-of-band management status before power operations.
+
 ~> **NOTE:** At present, 'PREPAID' instance cannot be deleted. Executing terraform destroy will only cancel the subscription, and the instance will not be immediately destroyed. It will be automatically destroyed after expiration.
 
 Example Usage
@@ -61,6 +67,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -95,9 +102,8 @@ func resourceZenlayerCloudInstance() *schema.Resource {
 			trafficPackageSizeForceNew(),
 			trafficPackageSizeValidFunc(),
 			trafficPackageSizeForPostPaidFunc(),
-			customdiff.ForceNewIf("user_data", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
-				return !diff.Get("reinstall").(bool)
-			}),
+			bmcInstanceReinstallForceNew,
+			bmcInstanceStorageValidFunc,
 		),
 		Schema: map[string]*schema.Schema{
 			"availability_zone": {
@@ -186,13 +192,13 @@ func resourceZenlayerCloudInstance() *schema.Resource {
 			"user_data": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "A string of the user data to be injected into this instance. If `reinstall` is set to `true`, updates to this field will trigger the instance reset instead of recreated.",
+				Description: "A string of the user data to be injected into this instance. If `reinstall` is set to `true`, updates to this field will trigger the instance reset, otherwise the instance is recreated.",
 			},
 			"reinstall": {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Default:     true,
-				Description: "Whether to reinstall the instance when modifying field including `user_data`. Default is `true`.",
+				Description: "Whether to reinstall the instance when modifying fields including `image_id`, `hostname`, `password`, `ssh_keys`, `key_id`, `partitions`, RAID config, nic names and `user_data`. If set false, modifying any of them will cause the instance recreated. Default is `true`.",
 			},
 			"internet_charge_type": {
 				Type:         schema.TypeString,
@@ -247,6 +253,24 @@ func resourceZenlayerCloudInstance() *schema.Resource {
 					},
 				},
 			},
+			"power_state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(BmcInstancePowerStates, false),
+				Description:  "The power state of the instance. Valid values: `on`, `off`. The instance is powered on by default.",
+			},
+			"reboot_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "An arbitrary value which reboots the instance whenever it changes, such as a timestamp. The reboot is skipped if the instance is powered off or `power_state` changes at the same time. The update waits until the status of the instance leaves `RUNNING` and comes back to `RUNNING`.",
+			},
+			"wait_for_healthy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicate whether to wait for the hardware of the instance to be healthy after the instance is created. Default is `false`. The instance is healthy when the CPU, disk and memory status are `OK`, and none of the power supply, fan and IPMI status is `WARNING` or `CRITICAL`.",
+			},
 			"force_delete": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -337,6 +361,31 @@ func resourceZenlayerCloudInstance() *schema.Resource {
 				Optional:    true,
 				Description: "The available tags within this instance.",
 			},
+			"effective_storage_layout": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The storage layout of the instance, built from the disks of instance type and the RAID config. Each element is a RAID array, or a single disk out of any RAID array.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"raid_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "RAID level of the volume. Empty if the volume is a single disk without RAID.",
+						},
+						"disk_sequence": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+							Description: "Sequence of the disks in the volume.",
+						},
+						"capacity": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Usable capacity of the volume. Unit: GB.",
+						},
+					},
+				},
+			},
 			"gateway_mode": {
 				Type:         schema.TypeString,
 				ValidateFunc: validation.StringInSlice([]string{"Enabled", "Disabled"}, false),
@@ -603,115 +652,248 @@ func resourceZenlayerCloudInstanceUpdate(ctx context.Context, d *schema.Resource
 		}
 	}
 	// need to reinstall the bmc instance
-	if d.HasChanges("hostname", "password", "ssh_keys", "image_id", "partitions", "raid_config_type", "raid_config_custom", "nic_lan_name", "nic_wan_name", "key_id", "user_data") {
-
-		request := bmc.NewReinstallInstanceRequest()
-		request.InstanceId = d.Id()
-		if v, ok := d.GetOk("hostname"); ok {
-			request.Hostname = v.(string)
+	if d.HasChanges(BmcInstanceReinstallFields...) {
+		request, err := buildBmcReinstallInstanceRequest(d)
+		if err != nil {
+			return diag.FromErr(err)
 		}
-		if v, ok := d.GetOk("password"); ok {
-			request.Password = v.(string)
+		// reinstall is not idempotent, so it is not retried
+		err = bmcService.reinstallInstance(ctx, request)
+		if err != nil {
+			return diag.FromErr(err)
 		}
-
-		if v, ok := d.GetOk("user_data"); ok {
-			request.UserData = common.String(v.(string))
+		if err = waitBmcInstanceReinstalled(ctx, bmcService, instanceId, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
 		}
-		if v, ok := d.GetOk("ssh_keys"); ok {
-			sshKeys := v.(*schema.Set).List()
-			if len(sshKeys) > 0 {
-				request.SshKeys = common2.ToStringList(sshKeys)
-			}
+	}
+	if d.HasChange("power_state") {
+		if err := setBmcInstancePowerState(ctx, bmcService, instanceId, d.Get("power_state").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
 		}
-		if v, ok := d.GetOk("key_id"); ok {
-			request.KeyId = common.String(v.(string))
+	} else if d.HasChange("reboot_trigger") && d.Get("reboot_trigger").(string) != "" &&
+		d.Get("power_state").(string) != BmcInstancePowerStateOff {
+		// reboot is not idempotent, so it is not retried
+		err := bmcService.RebootInstance(ctx, instanceId)
+		if err != nil {
+			return diag.FromErr(err)
 		}
-		if v, ok := d.GetOk("image_id"); ok {
-			request.ImageId = v.(string)
+		if err = waitBmcInstanceRebooted(ctx, bmcService, instanceId, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
 		}
-		// nic
-		if v, ok := d.GetOk("nic_wan_name"); ok {
-			request.Nic = &bmc.Nic{
-				WanName: v.(string),
-			}
+	}
+	// 更新标签
+	if d.HasChange("tags") {
+		zrmService := zrm.NewZrmService(meta.(*connectivity.ZenlayerCloudClient))
+		err := zrmService.ModifyResourceTags(ctx, d, instanceId)
+		if err != nil {
+			return diag.FromErr(err)
 		}
+	}
 
-		if v, ok := d.GetOk("nic_lan_name"); ok {
-			if request.Nic == nil {
-				request.Nic = &bmc.Nic{}
-			}
-			request.Nic.LanName = v.(string)
+	d.Partial(false)
+
+	return resourceZenlayerCloudInstanceRead(ctx, d, meta)
+}
+
+// bmcInstanceReinstallForceNew recreates the instance instead of reinstalling it in place
+// when any field applied by reinstall is changed with `reinstall` set to false.
+func bmcInstanceReinstallForceNew(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" || diff.Get("reinstall").(bool) {
+		return nil
+	}
+	for _, k := range BmcInstanceReinstallFields {
+		if !diff.HasChange(k) {
+			continue
 		}
-		// raid
-		if v, ok := d.GetOk("raid_config_type"); ok {
-			raidType, err := strconv.Atoi(v.(string))
-			if err != nil {
-				return diag.FromErr(err)
-			}
-			request.RaidConfig = &bmc.RaidConfig{
-				RaidType: common.Integer(raidType),
-			}
+		if err := diff.ForceNew(k); err != nil {
+			return err
 		}
+	}
+	return nil
+}
 
-		if v, ok := d.GetOk("raid_config_custom"); ok {
-			raidCustom := v.([]interface{})
-			raidParams := make([]*bmc.CustomRaid, 0, len(raidCustom))
-
-			for i := range raidCustom {
-				raidTypeKey := fmt.Sprintf("raid_config_custom.%d.raid_type", i)
-				diskSequenceKey := fmt.Sprintf("raid_config_custom.%d.disk_sequence", i)
+// buildBmcReinstallInstanceRequest builds the reinstall request from the full configuration,
+// so that unchanged settings such as `user_data` are applied again after reinstall.
+func buildBmcReinstallInstanceRequest(d *schema.ResourceData) (*bmc.ReinstallInstanceRequest, error) {
+	request := bmc.NewReinstallInstanceRequest()
+	request.InstanceId = d.Id()
+	if v, ok := d.GetOk("hostname"); ok {
+		request.Hostname = v.(string)
+	}
+	if v, ok := d.GetOk("password"); ok {
+		request.Password = v.(string)
+	}
+	if v, ok := d.GetOk("user_data"); ok {
+		request.UserData = common.String(v.(string))
+	}
+	if v, ok := d.GetOk("ssh_keys"); ok {
+		sshKeys := v.(*schema.Set).List()
+		if len(sshKeys) > 0 {
+			request.SshKeys = common2.ToStringList(sshKeys)
+		}
+	}
+	if v, ok := d.GetOk("key_id"); ok {
+		request.KeyId = common.String(v.(string))
+	}
+	if v, ok := d.GetOk("image_id"); ok {
+		request.ImageId = v.(string)
+	}
+	// nic
+	if v, ok := d.GetOk("nic_wan_name"); ok {
+		request.Nic = &bmc.Nic{
+			WanName: v.(string),
+		}
+	}
+	if v, ok := d.GetOk("nic_lan_name"); ok {
+		if request.Nic == nil {
+			request.Nic = &bmc.Nic{}
+		}
+		request.Nic.LanName = v.(string)
+	}
+	// raid
+	raidConfig, err := expandBmcRaidConfig(d.Get)
+	if err != nil {
+		return nil, err
+	}
+	request.RaidConfig = raidConfig
 
-				raidType, err := strconv.Atoi(d.Get(raidTypeKey).(string))
-				if err != nil {
-					return diag.FromErr(err)
-				}
-				diskSequence := d.Get(diskSequenceKey).([]interface{})
+	if v, ok := d.GetOk("partitions"); ok {
+		partitions := v.([]interface{})
+		request.Partitions = make([]*bmc.Partition, 0, len(partitions))
+		for _, partition := range partitions {
+			value := partition.(map[string]interface{})
+			request.Partitions = append(request.Partitions, &bmc.Partition{
+				FsType: value["fs_type"].(string),
+				FsPath: value["fs_path"].(string),
+				Size:   value["size"].(int),
+			})
+		}
+	}
+	return request, nil
+}
 
-				raidParams = append(raidParams, &bmc.CustomRaid{
-					RaidType:     common.Integer(raidType),
-					DiskSequence: common2.ToIntList(diskSequence),
-				})
-			}
-			request.RaidConfig = &bmc.RaidConfig{
-				CustomRaids: raidParams,
-			}
+func waitBmcInstanceReinstalled(ctx context.Context, bmcService BmcService, instanceId string, timeout time.Duration) error {
+	// the instance may still be running for a while after reinstall is accepted
+	startConf := &resource.StateChangeConf{
+		Pending: []string{
+			BmcInstanceStatusRunning,
+		},
+		Target: []string{
+			BmcInstanceStatusPending,
+			BmcInstanceStatusInstalling,
+			BmcInstanceStatusBooting,
+			BmcInstanceStatusInstallFailed,
+		},
+		Refresh:    bmcService.InstanceStateRefreshFunc(ctx, instanceId, []string{}),
+		Timeout:    2 * time.Minute,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := startConf.WaitForStateContext(ctx); err != nil {
+		if _, ok := err.(*resource.TimeoutError); !ok {
+			return fmt.Errorf("error waiting for bmc instance (%s) to start reinstalling: %v", instanceId, err)
 		}
+	}
 
-		if v, ok := d.GetOk("partitions"); ok {
-			partitions := v.([]interface{})
-			partitionValue := make([]*bmc.Partition, 0, len(partitions))
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			BmcInstanceStatusPending,
+			BmcInstanceStatusInstalling,
+			BmcInstanceStatusBooting,
+		},
+		Target: []string{
+			BmcInstanceStatusRunning,
+		},
+		Refresh:        bmcService.InstanceStateRefreshFunc(ctx, instanceId, []string{BmcInstanceStatusInstallFailed}),
+		Timeout:        timeout - 2*time.Minute,
+		Delay:          10 * time.Second,
+		MinTimeout:     5 * time.Second,
+		NotFoundChecks: 3,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for bmc instance (%s) to be reinstalled: %v", instanceId, err)
+	}
+	return nil
+}
 
-			for i := range partitions {
-				fsTypeKey := fmt.Sprintf("partitions.%d.fs_type", i)
-				fsPathKey := fmt.Sprintf("partitions.%d.fs_path", i)
-				sizeKey := fmt.Sprintf("partitions.%d.size", i)
+func setBmcInstancePowerState(ctx context.Context, bmcService BmcService, instanceId string, powerState string, timeout time.Duration) error {
+	operate := bmcService.StartInstance
+	pending := []string{BMC_INSTANCE_STATUS_STOPPED, BmcInstanceStatusBooting}
+	target := BmcInstanceStatusRunning
+	if powerState == BmcInstancePowerStateOff {
+		operate = bmcService.StopInstance
+		pending = []string{BmcInstanceStatusRunning, BmcInstanceStatusStopping}
+		target = BMC_INSTANCE_STATUS_STOPPED
+	}
 
-				partitionValue = append(partitionValue, &bmc.Partition{
-					FsType: d.Get(fsTypeKey).(string),
-					FsPath: d.Get(fsPathKey).(string),
-					Size:   d.Get(sizeKey).(int),
-				})
-			}
-			request.Partitions = partitionValue
+	err := resource.RetryContext(ctx, timeout-time.Minute, func() *resource.RetryError {
+		errRet := operate(ctx, instanceId)
+		if errRet != nil {
+			return common2.RetryError(ctx, errRet, common2.InternalServerError, common.NetworkError)
 		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
 
-		err := bmcService.reinstallInstance(ctx, request)
-		if err != nil {
-			return diag.FromErr(err)
-		}
+	stateConf := &resource.StateChangeConf{
+		Pending:        pending,
+		Target:         []string{target},
+		Refresh:        bmcService.InstanceStateRefreshFunc(ctx, instanceId, []string{}),
+		Timeout:        timeout - time.Minute,
+		Delay:          10 * time.Second,
+		MinTimeout:     5 * time.Second,
+		NotFoundChecks: 3,
 	}
-	// 更新标签
-	if d.HasChange("tags") {
-		zrmService := zrm.NewZrmService(meta.(*connectivity.ZenlayerCloudClient))
-		err := zrmService.ModifyResourceTags(ctx, d, instanceId)
-		if err != nil {
-			return diag.FromErr(err)
-		}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for bmc instance (%s) to be powered %s: %v", instanceId, powerState, err)
 	}
+	return nil
+}
 
-	d.Partial(false)
+func waitBmcInstanceRebooted(ctx context.Context, bmcService BmcService, instanceId string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			bmcInstanceStatusRebootAccepted,
+			BmcInstanceStatusStopping,
+			BMC_INSTANCE_STATUS_STOPPED,
+			BmcInstanceStatusBooting,
+		},
+		Target: []string{
+			BmcInstanceStatusRunning,
+		},
+		Refresh:        bmcInstanceRebootRefreshFunc(bmcService.InstanceStateRefreshFunc(ctx, instanceId, []string{})),
+		Timeout:        timeout - time.Minute,
+		Delay:          5 * time.Second,
+		MinTimeout:     5 * time.Second,
+		NotFoundChecks: 3,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for bmc instance (%s) to be rebooted: %v", instanceId, err)
+	}
+	return nil
+}
 
-	return resourceZenlayerCloudInstanceRead(ctx, d, meta)
+// bmcInstanceStatusRebootAccepted is reported while the instance is still running after reboot is accepted.
+const bmcInstanceStatusRebootAccepted = "REBOOT_ACCEPTED"
+
+// bmcInstanceRebootRefreshFunc reports the instance as rebooted only when it is running again
+// after its status has been observed to leave RUNNING.
+func bmcInstanceRebootRefreshFunc(refresh resource.StateRefreshFunc) resource.StateRefreshFunc {
+	left := false
+	return func() (interface{}, string, error) {
+		object, status, err := refresh()
+		if err != nil || object == nil {
+			return object, status, err
+		}
+		if status != BmcInstanceStatusRunning {
+			left = true
+		} else if !left {
+			return object, bmcInstanceStatusRebootAccepted, nil
+		}
+		return object, status, nil
+	}
 }
 
 func waitSubnetChangeOk(ctx context.Context, bmcService BmcService, d *schema.ResourceData, instanceId string, subnetId string, targetStatus string) error {
@@ -786,6 +968,9 @@ func resourceZenlayerCloudInstanceCreate(ctx context.Context, d *schema.Resource
 
 	var diags diag.Diagnostics
 
+	// the waits after the instance is running share what is left of the create timeout
+	createDeadline := time.Now().Add(d.Timeout(schema.TimeoutCreate))
+
 	bmcService := BmcService{
 		client: meta.(*connectivity.ZenlayerCloudClient),
 	}
@@ -983,9 +1168,45 @@ func resourceZenlayerCloudInstanceCreate(ctx context.Context, d *schema.Resource
 		return diag.FromErr(fmt.Errorf("error waiting for bmc instance (%s) to be created: %v", d.Id(), err))
 	}
 
+	if d.Get("wait_for_healthy").(bool) {
+		if err := waitBmcInstanceHealthy(ctx, bmcService, instanceId, time.Until(createDeadline)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.Get("power_state").(string) == BmcInstancePowerStateOff {
+		if err := setBmcInstancePowerState(ctx, bmcService, instanceId, BmcInstancePowerStateOff, time.Until(createDeadline)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceZenlayerCloudInstanceRead(ctx, d, meta)
 }
 
+func waitBmcInstanceHealthy(ctx context.Context, bmcService BmcService, instanceId string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			BmcInstanceHealthUnhealthy,
+		},
+		Target: []string{
+			BmcInstanceHealthHealthy,
+		},
+		Refresh:        bmcService.InstanceHealthRefreshFunc(ctx, instanceId),
+		Timeout:        timeout - time.Minute,
+		Delay:          10 * time.Second,
+		MinTimeout:     30 * time.Second,
+		NotFoundChecks: 10,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		if health, e := bmcService.DescribeInstanceMonitorHealth(ctx, instanceId); e == nil && health != nil {
+			return fmt.Errorf("error waiting for bmc instance (%s) to be healthy, unhealthy items: %s: %v", instanceId, strings.Join(bmcInstanceUnhealthyItems(health), ", "), err)
+		}
+		return fmt.Errorf("error waiting for bmc instance (%s) to be healthy: %v", instanceId, err)
+	}
+	return nil
+}
+
 func resourceZenlayerCloudInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -1054,6 +1275,9 @@ func resourceZenlayerCloudInstanceRead(ctx context.Context, d *schema.ResourceDa
 	_ = d.Set("public_ipv6_addresses", instance.Ipv6Addresses)
 	_ = d.Set("private_ip_addresses", instance.PrivateIpAddresses)
 	_ = d.Set("instance_status", instance.InstanceStatus)
+	if powerState := bmcInstancePowerState(instance.InstanceStatus); powerState != "" {
+		_ = d.Set("power_state", powerState)
+	}
 	_ = d.Set("create_time", instance.CreateTime)
 	_ = d.Set("expired_time", instance.ExpiredTime)
 	if common.ToBool(instance.EnableGatewayMode) {
@@ -1100,6 +1324,33 @@ func resourceZenlayerCloudInstanceRead(ctx context.Context, d *schema.ResourceDa
 		_ = d.Set("raid_config_custom", customRaids)
 	}
 
+	// effective_storage_layout is informative, failing to query the instance type doesn't fail the refresh
+	var instanceType *bmc.InstanceType
+	err = resource.RetryContext(ctx, common2.ReadRetryTimeout, func() *resource.RetryError {
+		instanceType, errRet = bmcService.DescribeInstanceTypeById(ctx, instance.InstanceTypeId)
+		if errRet != nil {
+			return common2.RetryError(ctx, errRet, common2.InternalServerError, common.NetworkError)
+		}
+		return nil
+	})
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Fail to query instance type of bmc instance",
+			Detail:   fmt.Sprintf("effective_storage_layout of instance %s is not refreshed: %v", instanceId, err),
+		})
+	} else if instanceType != nil && instanceType.DiskInfo != nil {
+		volumes, err := buildBmcStorageLayout(expandBmcInstanceDisks(instanceType.DiskInfo), nil, instance.RaidConfig)
+		if err != nil {
+			tflog.Warn(ctx, "Fail to build storage layout of bmc instance", map[string]interface{}{
+				"instanceId": instanceId,
+				"err":        err.Error(),
+			})
+		} else {
+			_ = d.Set("effective_storage_layout", flattenBmcStorageLayout(volumes))
+		}
+	}
+
 	// 设置实例标签
 	tagMap, err := common2.TagsToMap(instance.Tags)
 	if err != nil {
@@ -1110,3 +1361,267 @@ func resourceZenlayerCloudInstanceRead(ctx context.Context, d *schema.ResourceDa
 	return diags
 
 }
+
+func bmcInstanceStorageValidFunc(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if meta == nil {
+		return nil
+	}
+	if diff.Id() != "" {
+		if !diff.HasChanges("raid_config_type", "raid_config_custom", "partitions") {
+			return nil
+		}
+		_ = diff.SetNewComputed("effective_storage_layout")
+	}
+	for _, k := range []string{"instance_type_id", "raid_config_type", "raid_config_custom", "partitions"} {
+		if !diff.NewValueKnown(k) {
+			// validate it in apply stage
+			return nil
+		}
+	}
+
+	raidConfig, err := expandBmcRaidConfig(diff.Get)
+	if err != nil {
+		return err
+	}
+	partitions := diff.Get("partitions").([]interface{})
+	if raidConfig == nil && len(partitions) == 0 {
+		return nil
+	}
+
+	bmcService := BmcService{
+		client: meta.(*connectivity.ZenlayerCloudClient),
+	}
+	instanceTypeId := diff.Get("instance_type_id").(string)
+	var instanceType *bmc.InstanceType
+	err = resource.RetryContext(ctx, common2.ReadRetryTimeout, func() *resource.RetryError {
+		var errRet error
+		instanceType, errRet = bmcService.DescribeInstanceTypeById(ctx, instanceTypeId)
+		if errRet != nil {
+			return common2.RetryError(ctx, errRet, common2.InternalServerError, common.NetworkError)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if instanceType == nil || instanceType.DiskInfo == nil || len(instanceType.DiskInfo.Disks) == 0 {
+		// disk inventory is unknown, leave it to the API
+		return nil
+	}
+
+	volumes, err := buildBmcStorageLayout(expandBmcInstanceDisks(instanceType.DiskInfo), instanceType.SupportRaids, raidConfig)
+	if err != nil {
+		return fmt.Errorf("invalid RAID config for instance type %s: %v", instanceTypeId, err)
+	}
+
+	partitionSizes := make([]int, 0, len(partitions))
+	for _, partition := range partitions {
+		partitionSizes = append(partitionSizes, partition.(map[string]interface{})["size"].(int))
+	}
+	if err = checkBmcPartitionSize(volumes, partitionSizes); err != nil {
+		return fmt.Errorf("invalid partitions for instance type %s: %v", instanceTypeId, err)
+	}
+	return nil
+}
+
+func expandBmcRaidConfig(get func(string) interface{}) (*bmc.RaidConfig, error) {
+	if v := get("raid_config_type").(string); v != "" {
+		raidType, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, err
+		}
+		return &bmc.RaidConfig{
+			RaidType: common.Integer(raidType),
+		}, nil
+	}
+
+	customRaidConfig := get("raid_config_custom").([]interface{})
+	if len(customRaidConfig) == 0 {
+		return nil, nil
+	}
+	raidConfig := &bmc.RaidConfig{
+		CustomRaids: make([]*bmc.CustomRaid, 0, len(customRaidConfig)),
+	}
+	for _, v := range customRaidConfig {
+		value := v.(map[string]interface{})
+		raidType, err := strconv.Atoi(value["raid_type"].(string))
+		if err != nil {
+			return nil, err
+		}
+		raidConfig.CustomRaids = append(raidConfig.CustomRaids, &bmc.CustomRaid{
+			RaidType:     common.Integer(raidType),
+			DiskSequence: common2.ToIntList(value["disk_sequence"].([]interface{})),
+		})
+	}
+	return raidConfig, nil
+}
+
+// bmcStorageVolume is a RAID array, or a single disk out of any RAID array.
+type bmcStorageVolume struct {
+	RaidType     string
+	DiskSequence []int
+	Capacity     int
+}
+
+// expandBmcInstanceDisks returns the size of each disk, the size of disk numbered n is at index n-1.
+func expandBmcInstanceDisks(diskInfo *bmc.InstanceDiskInfo) []int {
+	var diskSizes []int
+	for _, disk := range diskInfo.Disks {
+		for i := 0; i < disk.DiskCount; i++ {
+			diskSizes = append(diskSizes, disk.DiskSize)
+		}
+	}
+	return diskSizes
+}
+
+func bmcRaidCapacity(raidType int, diskSizes []int) (int, error) {
+	count := len(diskSizes)
+	minSize := 0
+	for i, size := range diskSizes {
+		if i == 0 || size < minSize {
+			minSize = size
+		}
+	}
+	switch raidType {
+	case 0:
+		if count < 1 {
+			return 0, fmt.Errorf("raid0 requires at least 1 disk, got %d", count)
+		}
+		return count * minSize, nil
+	case 1:
+		if count < 2 {
+			return 0, fmt.Errorf("raid1 requires at least 2 disks, got %d", count)
+		}
+		return minSize, nil
+	case 5:
+		if count < 3 {
+			return 0, fmt.Errorf("raid5 requires at least 3 disks, got %d", count)
+		}
+		return (count - 1) * minSize, nil
+	case 10:
+		if count < 4 || count%2 != 0 {
+			return 0, fmt.Errorf("raid10 requires an even number of disks and at least 4 disks, got %d", count)
+		}
+		return count / 2 * minSize, nil
+	}
+	return 0, fmt.Errorf("unsupported raid type %d", raidType)
+}
+
+// buildBmcStorageLayout returns the volumes of the instance for the given disks and RAID config.
+func buildBmcStorageLayout(diskSizes []int, supportRaids []int, raidConfig *bmc.RaidConfig) ([]*bmcStorageVolume, error) {
+	checkSupported := func(raidType int) error {
+		if len(supportRaids) == 0 {
+			return nil
+		}
+		for _, r := range supportRaids {
+			if r == raidType {
+				return nil
+			}
+		}
+		return fmt.Errorf("raid%d is not supported, supported RAID levels: %v", raidType, supportRaids)
+	}
+
+	used := make([]bool, len(diskSizes))
+	var volumes []*bmcStorageVolume
+	if raidConfig != nil && raidConfig.RaidType != nil {
+		raidType := *raidConfig.RaidType
+		if err := checkSupported(raidType); err != nil {
+			return nil, err
+		}
+		capacity, err := bmcRaidCapacity(raidType, diskSizes)
+		if err != nil {
+			return nil, err
+		}
+		sequence := make([]int, 0, len(diskSizes))
+		for i := range diskSizes {
+			sequence = append(sequence, i+1)
+			used[i] = true
+		}
+		volumes = append(volumes, &bmcStorageVolume{
+			RaidType:     strconv.Itoa(raidType),
+			DiskSequence: sequence,
+			Capacity:     capacity,
+		})
+	} else if raidConfig != nil {
+		for _, customRaid := range raidConfig.CustomRaids {
+			if customRaid.RaidType == nil {
+				return nil, fmt.Errorf("raid type of disk sequence %v is missing", customRaid.DiskSequence)
+			}
+			raidType := *customRaid.RaidType
+			if err := checkSupported(raidType); err != nil {
+				return nil, err
+			}
+			sizes := make([]int, 0, len(customRaid.DiskSequence))
+			for _, seq := range customRaid.DiskSequence {
+				if seq < 1 || seq > len(diskSizes) {
+					return nil, fmt.Errorf("disk %d doesn't exist, valid disk sequence: [1,%d]", seq, len(diskSizes))
+				}
+				if used[seq-1] {
+					return nil, fmt.Errorf("disk %d is used by more than one RAID array", seq)
+				}
+				used[seq-1] = true
+				sizes = append(sizes, diskSizes[seq-1])
+			}
+			capacity, err := bmcRaidCapacity(raidType, sizes)
+			if err != nil {
+				return nil, err
+			}
+			volumes = append(volumes, &bmcStorageVolume{
+				RaidType:     strconv.Itoa(raidType),
+				DiskSequence: customRaid.DiskSequence,
+				Capacity:     capacity,
+			})
+		}
+	}
+
+	for i, size := range diskSizes {
+		if used[i] {
+			continue
+		}
+		volumes = append(volumes, &bmcStorageVolume{
+			DiskSequence: []int{i + 1},
+			Capacity:     size,
+		})
+	}
+	return volumes, nil
+}
+
+// checkBmcPartitionSize checks that every partition fits in a single volume, as a partition can not span volumes,
+// and that the partitions fit in the volumes altogether.
+func checkBmcPartitionSize(volumes []*bmcStorageVolume, partitionSizes []int) error {
+	capacity := 0
+	var largest *bmcStorageVolume
+	for _, volume := range volumes {
+		capacity += volume.Capacity
+		if largest == nil || volume.Capacity > largest.Capacity {
+			largest = volume
+		}
+	}
+	total := 0
+	for i, size := range partitionSizes {
+		if largest == nil || size > largest.Capacity {
+			largestCapacity := 0
+			if largest != nil {
+				largestCapacity = largest.Capacity
+			}
+			return fmt.Errorf("size of partition %d (%d GB) exceeds the usable capacity of any volume, the largest volume is %d GB", i+1, size, largestCapacity)
+		}
+		total += size
+	}
+	if total > capacity {
+		return fmt.Errorf("total size of partitions %d GB exceeds the usable capacity %d GB", total, capacity)
+	}
+	return nil
+}
+
+func flattenBmcStorageLayout(volumes []*bmcStorageVolume) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(volumes))
+	for _, volume := range volumes {
+		result = append(result, map[string]interface{}{
+			"raid_type":     volume.RaidType,
+			"disk_sequence": volume.DiskSequence,
+			"capacity":      volume.Capacity,
+		})
+	}
+	return result
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/common"
 	"github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/connectivity"
+	bmc "github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/bmc20221120"
 	"testing"
 )
 
@@ -199,3 +200,168 @@ resource "zenlayercloud_bmc_instance" "foo" {
   password             = var.password
 }
 `
+
+func TestBuildBmcStorageLayout(t *testing.T) {
+	// 880 x 2, 220 x 2
+	diskSizes := expandBmcInstanceDisks(&bmc.InstanceDiskInfo{
+		Disks: []*bmc.Disk{{DiskSize: 880, DiskCount: 2}, {DiskSize: 220, DiskCount: 2}},
+	})
+	raidType := func(v int) *int { return &v }
+
+	cases := []struct {
+		name       string
+		raidConfig *bmc.RaidConfig
+		capacities []int
+		expectErr  bool
+	}{
+		{"no raid", nil, []int{880, 880, 220, 220}, false},
+		{"raid10", &bmc.RaidConfig{RaidType: raidType(10)}, []int{440}, false},
+		{"raid5", &bmc.RaidConfig{RaidType: raidType(5)}, []int{660}, false},
+		{"unsupported raid", &bmc.RaidConfig{RaidType: raidType(6)}, nil, true},
+		{"custom", &bmc.RaidConfig{CustomRaids: []*bmc.CustomRaid{
+			{RaidType: raidType(1), DiskSequence: []int{1, 2}},
+		}}, []int{880, 220, 220}, false},
+		{"custom disk not exist", &bmc.RaidConfig{CustomRaids: []*bmc.CustomRaid{
+			{RaidType: raidType(1), DiskSequence: []int{4, 5}},
+		}}, nil, true},
+		{"custom disk reused", &bmc.RaidConfig{CustomRaids: []*bmc.CustomRaid{
+			{RaidType: raidType(1), DiskSequence: []int{1, 2}},
+			{RaidType: raidType(1), DiskSequence: []int{2, 3}},
+		}}, nil, true},
+		{"custom not enough disks", &bmc.RaidConfig{CustomRaids: []*bmc.CustomRaid{
+			{RaidType: raidType(5), DiskSequence: []int{1, 2}},
+		}}, nil, true},
+		{"custom non-adjacent disks", &bmc.RaidConfig{CustomRaids: []*bmc.CustomRaid{
+			{RaidType: raidType(1), DiskSequence: []int{1, 3}},
+		}}, []int{220, 880, 220}, false},
+		{"custom raid0 single disk", &bmc.RaidConfig{CustomRaids: []*bmc.CustomRaid{
+			{RaidType: raidType(0), DiskSequence: []int{1}},
+		}}, []int{880, 880, 220, 220}, false},
+	}
+	for _, c := range cases {
+		volumes, err := buildBmcStorageLayout(diskSizes, []int{0, 1, 5, 10}, c.raidConfig)
+		if (err != nil) != c.expectErr {
+			t.Errorf("%s: expect error %v, got %v", c.name, c.expectErr, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		capacities := make([]int, 0, len(volumes))
+		for _, volume := range volumes {
+			capacities = append(capacities, volume.Capacity)
+		}
+		if fmt.Sprint(capacities) != fmt.Sprint(c.capacities) {
+			t.Errorf("%s: expect capacities %v, got %v", c.name, c.capacities, capacities)
+		}
+	}
+}
+
+func TestCheckBmcPartitionSize(t *testing.T) {
+	volumes := []*bmcStorageVolume{{RaidType: "1", DiskSequence: []int{1, 2}, Capacity: 880}}
+	if err := checkBmcPartitionSize(volumes, []int{100, 780}); err != nil {
+		t.Errorf("expect no error, got %v", err)
+	}
+	if err := checkBmcPartitionSize(volumes, []int{100, 800}); err == nil {
+		t.Errorf("expect error for partitions exceeding capacity")
+	}
+
+	volumes = append(volumes, &bmcStorageVolume{DiskSequence: []int{3}, Capacity: 220})
+	if err := checkBmcPartitionSize(volumes, []int{800, 200}); err != nil {
+		t.Errorf("expect no error, got %v", err)
+	}
+	if err := checkBmcPartitionSize(volumes, []int{900}); err == nil {
+		t.Errorf("expect error for partition exceeding the largest volume")
+	}
+}
+
+func TestBmcInstanceUnhealthyItems(t *testing.T) {
+	health := &bmc.InstanceHealth{
+		CpuStatus:    BmcHealthStatusOk,
+		DiskStatus:   BmcHealthStatusOk,
+		MemoryStatus: BmcHealthStatusOk,
+		PsuStatus:    BmcHealthStatusUnknown,
+		IpmiPing:     BmcHealthStatusOk,
+	}
+	if items := bmcInstanceUnhealthyItems(health); len(items) != 0 {
+		t.Errorf("expect healthy, got %v", items)
+	}
+
+	health.DiskStatus = BmcHealthStatusUnknown
+	health.FanStatus = BmcHealthStatusWarning
+	items := bmcInstanceUnhealthyItems(health)
+	if fmt.Sprint(items) != fmt.Sprint([]string{"disk_status=UNKNOWN", "fan_status=WARNING"}) {
+		t.Errorf("unexpected unhealthy items: %v", items)
+	}
+}
+
+func TestBmcInstancePowerState(t *testing.T) {
+	cases := map[string]string{
+		BmcInstanceStatusRunning:    BmcInstancePowerStateOn,
+		BMC_INSTANCE_STATUS_STOPPED: BmcInstancePowerStateOff,
+		BmcInstanceStatusInstalling: "",
+	}
+	for status, expect := range cases {
+		if got := bmcInstancePowerState(status); got != expect {
+			t.Errorf("%s: expect power state %q, got %q", status, expect, got)
+		}
+	}
+}
+
+func TestBmcInstanceRebootRefreshFunc(t *testing.T) {
+	statuses := []string{BmcInstanceStatusRunning, BmcInstanceStatusRunning, BmcInstanceStatusBooting, BmcInstanceStatusRunning}
+	expects := []string{bmcInstanceStatusRebootAccepted, bmcInstanceStatusRebootAccepted, BmcInstanceStatusBooting, BmcInstanceStatusRunning}
+	i := 0
+	refresh := bmcInstanceRebootRefreshFunc(func() (interface{}, string, error) {
+		status := statuses[i]
+		i++
+		return &bmc.InstanceInfo{InstanceStatus: status}, status, nil
+	})
+	for _, expect := range expects {
+		if _, status, err := refresh(); err != nil || status != expect {
+			t.Errorf("expect status %s, got %s, %v", expect, status, err)
+		}
+	}
+}
+
+func TestBmcInstanceReinstallForceNew(t *testing.T) {
+	state := &terraform.InstanceState{ID: "instance-1", Attributes: map[string]string{
+		"id":                   "instance-1",
+		"availability_zone":    "SEL-A",
+		"instance_type_id":     "M6C",
+		"image_id":             "image-1",
+		"hostname":             "host",
+		"reinstall":            "true",
+		"instance_charge_type": "POSTPAID",
+		"internet_charge_type": "ByBandwidth",
+	}}
+	cases := []struct {
+		name        string
+		reinstall   bool
+		imageId     string
+		requiresNew bool
+	}{
+		{"reinstall in place", true, "image-2", false},
+		{"recreate", false, "image-2", true},
+		{"unchanged", false, "image-1", false},
+	}
+	for _, c := range cases {
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"availability_zone":    "SEL-A",
+			"instance_type_id":     "M6C",
+			"image_id":             c.imageId,
+			"hostname":             "host",
+			"reinstall":            c.reinstall,
+			"instance_charge_type": "POSTPAID",
+			"internet_charge_type": "ByBandwidth",
+		})
+		diff, err := resourceZenlayerCloudInstance().SimpleDiff(context.Background(), state, config, nil)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", c.name, err)
+			continue
+		}
+		if requiresNew := diff != nil && diff.RequiresNew(); requiresNew != c.requiresNew {
+			t.Errorf("%s: requires new = %v, want %v", c.name, requiresNew, c.requiresNew)
+		}
+	}
+}
@@ -0,0 +1,634 @@
+/*
+Provides a resource to manage layer 3 cloud router.
+
+~> **NOTE:** Only the edge points created by `edge_points` are managed by this resource. Edge points are added, removed or modified in place: bandwidth, IP address, BGP and static routes of an edge point are modified, changing other arguments of it replaces the edge point instead of the cloud router. Edge points can also be managed by `zenlayercloud_sdn_cloud_router_edge_point`, do not configure the same edge point in both.
+
+~> **NOTE:** Advertised prefixes of a BGP session are learned from the peer, they can not be configured through this resource.
+
+Example Usage
+
+```hcl
+
+resource "zenlayercloud_sdn_cloud_router" "default" {
+  cr_name        = "Test"
+  cr_description = "cloud router created by terraform"
+
+  edge_points {
+    point_name   = "port-point"
+    point_type   = "PORT"
+    port_id      = "<portId>"
+    vlan_id      = 1020
+    bandwidth    = 20
+    ip_address   = "10.10.10.1/30"
+    route_type   = "BGP"
+    bgp_asn      = 65001
+    bgp_peer_ip  = "10.10.10.2"
+    bgp_password = "password"
+  }
+
+  edge_points {
+    point_name    = "aws-point"
+    point_type    = "AWS"
+    datacenter    = "SOF1"
+    cloud_region  = "eu-west-1"
+    cloud_account = "123412341234"
+    vlan_id       = 1457
+    bandwidth     = 20
+    ip_address    = "10.10.20.1/30"
+    route_type    = "STATIC"
+
+    static_routes {
+      prefix   = "172.16.0.0/16"
+      next_hop = "10.10.20.2"
+    }
+  }
+}
+
+```
+
+Import
+
+Cloud router can be imported, e.g.
+
+```
+$ terraform import zenlayercloud_sdn_cloud_router.default cloudRouterId
+```
+
+All the edge points of the cloud router are imported into `edge_points` in this way. If some of them are managed by `zenlayercloud_sdn_cloud_router_edge_point`, import the cloud router using the id `cloudRouterId:edgePointId1,edgePointId2` with the IDs of the edge points of `edge_points`, the others are excluded, e.g.
+
+```
+$ terraform import zenlayercloud_sdn_cloud_router.default cloudRouterId:edgePointId1,edgePointId2
+```
+*/
+package zenlayercloud
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	common2 "github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/common"
+	"github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/connectivity"
+	"github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/common"
+	sdn "github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/sdn20230830"
+)
+
+func resourceZenlayerCloudSdnCloudRouter() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceZenlayerCloudSdnCloudRouterCreate,
+		ReadContext:   resourceZenlayerCloudSdnCloudRouterRead,
+		UpdateContext: resourceZenlayerCloudSdnCloudRouterUpdate,
+		DeleteContext: resourceZenlayerCloudSdnCloudRouterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceZenlayerCloudSdnCloudRouterImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+			points := diff.Get("edge_points").([]interface{})
+			for i := range points {
+				prefix := fmt.Sprintf("edge_points.%d.", i)
+				if !isCloudRouterEdgePointKnown(diff, prefix) {
+					continue
+				}
+				err := validateCloudRouterEdgePoint(sdnCloudRouterEdgePointValues(func(k string) interface{} {
+					return diff.Get(prefix + k)
+				}))
+				if err != nil {
+					return fmt.Errorf("edge_points.%d: %v", i, err)
+				}
+			}
+			return nil
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cr_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Terraform-Cloud-Router",
+				ValidateFunc: validation.StringLenBetween(1, 255),
+				Description:  "The cloud router name. Up to 255 characters in length are allowed. Default is `Terraform-Cloud-Router`.",
+			},
+			"cr_description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+				Description:  "The description of cloud router.",
+			},
+			"resource_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The resource group ID the cloud router belongs to, default to Default Resource Group. Modification is not supported.",
+			},
+			"edge_points": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "Access points created with the cloud router.",
+				Elem: &schema.Resource{
+					Schema: sdnCloudRouterInlineEdgePointSchema(),
+				},
+			},
+			"force_delete": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicate whether to force delete the cloud router. Default is `false`. If set true, the cloud router will be permanently deleted instead of being moved into the recycle bin.",
+			},
+			"cr_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The business status of cloud router.",
+			},
+			"connectivity_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Network connectivity state. ACTIVE means the network is connected. DOWN which means not connected.",
+			},
+			"resource_group_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Name of resource group.",
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Create time of the cloud router.",
+			},
+			"expired_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expired time of the cloud router.",
+			},
+		},
+	}
+}
+
+func resourceZenlayerCloudSdnCloudRouterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer common2.LogElapsed(ctx, "resource.zenlayercloud_sdn_cloud_router.create")()
+
+	sdnService := SdnService{
+		client: meta.(*connectivity.ZenlayerCloudClient),
+	}
+
+	request := sdn.NewCreateCloudRouterRequest()
+	request.CloudRouterName = d.Get("cr_name").(string)
+	request.CloudRouterDescription = d.Get("cr_description").(string)
+	request.ResourceGroupId = d.Get("resource_group_id").(string)
+	for _, v := range d.Get("edge_points").([]interface{}) {
+		request.EdgePoints = append(request.EdgePoints, expandCloudRouterEdgePoint(v.(map[string]interface{})))
+	}
+	cloudRouterId := ""
+
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		response, err := meta.(*connectivity.ZenlayerCloudClient).WithSdnClient().CreateCloudRouter(request)
+		if err != nil {
+			tflog.Info(ctx, "Fail to create cloud router.", map[string]interface{}{
+				"action":  request.GetAction(),
+				"request": common2.ToJsonString(request),
+				"err":     err.Error(),
+			})
+			return common2.RetryError(ctx, err)
+		}
+
+		tflog.Info(ctx, "Create cloud router success", map[string]interface{}{
+			"action":   request.GetAction(),
+			"request":  common2.ToJsonString(request),
+			"response": common2.ToJsonString(response),
+		})
+
+		if response.Response.CloudRouterId == "" {
+			err = fmt.Errorf("cloudRouterId is nil")
+			return resource.NonRetryableError(err)
+		}
+		cloudRouterId = response.Response.CloudRouterId
+
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(cloudRouterId)
+
+	if err = waitCloudRouterRunning(ctx, &sdnService, cloudRouterId, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the created edge points are matched with the configured ones, so that they keep the order of `edge_points`
+	var cloudRouter *sdn.CloudRouter
+	err = resource.RetryContext(ctx, common2.ReadRetryTimeout, func() *resource.RetryError {
+		var errRet error
+		cloudRouter, errRet = sdnService.DescribeCloudRouterById(ctx, cloudRouterId)
+		if errRet != nil {
+			return common2.RetryError(ctx, errRet)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if cloudRouter != nil {
+		created := make([]interface{}, 0, len(cloudRouter.EdgePoints))
+		for _, point := range cloudRouter.EdgePoints {
+			created = append(created, flattenCloudRouterEdgePoint(point))
+		}
+		points := expandCloudRouterInlineEdgePoints(d)
+		var matched []interface{}
+		for i, j := range matchCloudRouterEdgePoints(points, created) {
+			if j >= 0 {
+				matched = append(matched, map[string]interface{}{"point_id": created[j].(map[string]interface{})["point_id"]})
+			} else {
+				tflog.Warn(ctx, "Fail to find the created edge point of cloud router", map[string]interface{}{
+					"cloudRouterId": cloudRouterId,
+					"index":         i,
+				})
+			}
+		}
+		_ = d.Set("edge_points", matched)
+	}
+
+	return resourceZenlayerCloudSdnCloudRouterRead(ctx, d, meta)
+}
+
+func resourceZenlayerCloudSdnCloudRouterImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	cloudRouterId, edgePointIds, found := strings.Cut(d.Id(), ":")
+	if !found {
+		return []*schema.ResourceData{d}, nil
+	}
+	if cloudRouterId == "" || edgePointIds == "" {
+		return nil, fmt.Errorf("invalid cloud router id %s, expected format `cloudRouterId` or `cloudRouterId:edgePointId1,edgePointId2`", d.Id())
+	}
+	var points []interface{}
+	for _, edgePointId := range strings.Split(edgePointIds, ",") {
+		points = append(points, map[string]interface{}{"point_id": strings.TrimSpace(edgePointId)})
+	}
+	d.SetId(cloudRouterId)
+	if err := d.Set("edge_points", points); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceZenlayerCloudSdnCloudRouterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer common2.LogElapsed(ctx, "resource.zenlayercloud_sdn_cloud_router.read")()
+
+	var diags diag.Diagnostics
+
+	cloudRouterId := d.Id()
+
+	sdnService := SdnService{
+		client: meta.(*connectivity.ZenlayerCloudClient),
+	}
+
+	var cloudRouter *sdn.CloudRouter
+	var errRet error
+
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutRead)-time.Minute, func() *resource.RetryError {
+		cloudRouter, errRet = sdnService.DescribeCloudRouterById(ctx, cloudRouterId)
+		if errRet != nil {
+			return common2.RetryError(ctx, errRet)
+		}
+		if cloudRouter != nil && IsOperating(cloudRouter.CloudRouterStatus) {
+			return resource.RetryableError(fmt.Errorf("waiting for cloud router %s operation, current status: %s", cloudRouter.CloudRouterId, cloudRouter.CloudRouterStatus))
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if cloudRouter == nil || cloudRouter.CloudRouterStatus == SdnStatusRecycle {
+		d.SetId("")
+		tflog.Info(ctx, "cloud router not exist", map[string]interface{}{
+			"cloudRouterId": cloudRouterId,
+		})
+		return nil
+	}
+
+	_ = d.Set("cr_name", cloudRouter.CloudRouterName)
+	_ = d.Set("cr_description", cloudRouter.CloudRouterDescription)
+	_ = d.Set("cr_status", cloudRouter.CloudRouterStatus)
+	_ = d.Set("connectivity_status", cloudRouter.ConnectivityStatus)
+	_ = d.Set("resource_group_id", cloudRouter.ResourceGroupId)
+	_ = d.Set("resource_group_name", cloudRouter.ResourceGroupName)
+	_ = d.Set("create_time", cloudRouter.CreateTime)
+	_ = d.Set("expired_time", cloudRouter.ExpiredTime)
+
+	// Edge points added by zenlayercloud_sdn_cloud_router_edge_point are not part of this resource.
+	var points []interface{}
+	statePoints := d.Get("edge_points").([]interface{})
+	if len(statePoints) == 0 {
+		for _, point := range cloudRouter.EdgePoints {
+			points = append(points, flattenCloudRouterEdgePoint(point))
+		}
+	} else {
+		for _, v := range statePoints {
+			pointId := v.(map[string]interface{})["point_id"].(string)
+			if point := findCloudRouterEdgePoint(cloudRouter, pointId); point != nil {
+				points = append(points, flattenCloudRouterEdgePoint(point))
+			}
+		}
+	}
+	_ = d.Set("edge_points", points)
+
+	return diags
+}
+
+func resourceZenlayerCloudSdnCloudRouterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer common2.LogElapsed(ctx, "resource.zenlayercloud_sdn_cloud_router.update")()
+
+	sdnService := SdnService{
+		client: meta.(*connectivity.ZenlayerCloudClient),
+	}
+	cloudRouterId := d.Id()
+
+	if d.HasChanges("cr_name", "cr_description") {
+		err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate)-time.Minute, func() *resource.RetryError {
+			err := sdnService.ModifyCloudRouterAttribute(ctx, cloudRouterId, d.Get("cr_name").(string), d.Get("cr_description").(string))
+			if err != nil {
+				return common2.RetryError(ctx, err, common2.InternalServerError, common.NetworkError)
+			}
+			return nil
+		})
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("edge_points") {
+		o, _ := d.GetChange("edge_points")
+		points, err := applyCloudRouterEdgePoints(ctx, &sdnService, cloudRouterId, o.([]interface{}), expandCloudRouterInlineEdgePoints(d), d.Timeout(schema.TimeoutUpdate))
+		// keep the edge points added or not yet deleted even if it fails
+		_ = d.Set("edge_points", points)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceZenlayerCloudSdnCloudRouterRead(ctx, d, meta)
+}
+
+func resourceZenlayerCloudSdnCloudRouterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer common2.LogElapsed(ctx, "resource.zenlayercloud_sdn_cloud_router.delete")()
+
+	// force_delete: terminate and then delete
+	forceDelete := d.Get("force_delete").(bool)
+
+	sdnService := SdnService{
+		client: meta.(*connectivity.ZenlayerCloudClient),
+	}
+
+	cloudRouterId := d.Id()
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete)-time.Minute, func() *resource.RetryError {
+		errRet := sdnService.DeleteCloudRouterById(ctx, cloudRouterId)
+		if errRet != nil {
+			return common2.RetryError(ctx, errRet)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	notExist := false
+
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete)-time.Minute, func() *resource.RetryError {
+		cloudRouter, errRet := sdnService.DescribeCloudRouterById(ctx, cloudRouterId)
+		if errRet != nil {
+			return common2.RetryError(ctx, errRet, common2.InternalServerError)
+		}
+		if cloudRouter == nil {
+			notExist = true
+			return nil
+		}
+
+		if cloudRouter.CloudRouterStatus == SdnStatusRecycle {
+			//in recycling
+			return nil
+		}
+
+		if IsOperating(cloudRouter.CloudRouterStatus) {
+			return resource.RetryableError(fmt.Errorf("waiting for cloud router %s recycling, current status: %s", cloudRouter.CloudRouterId, cloudRouter.CloudRouterStatus))
+		}
+
+		return resource.NonRetryableError(fmt.Errorf("cloud router status is not recycle, current status %s", cloudRouter.CloudRouterStatus))
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if notExist || !forceDelete {
+		return nil
+	}
+
+	tflog.Debug(ctx, "Releasing cloud router ...", map[string]interface{}{
+		"cloudRouterId": cloudRouterId,
+	})
+
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete)-time.Minute, func() *resource.RetryError {
+		errRet := sdnService.DestroyCloudRouter(ctx, cloudRouterId)
+		if errRet != nil {
+			ee, ok := errRet.(*common.ZenlayerCloudSdkError)
+			if !ok {
+				return common2.RetryError(ctx, errRet)
+			}
+			if ee.Code == "INVALID_CLOUD_ROUTER_NOT_FOUND" || ee.Code == common2.ResourceNotFound {
+				// cloud router doesn't exist
+				return nil
+			}
+			return common2.RetryError(ctx, errRet, common2.InternalServerError)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// sdnCloudRouterInlineEdgePointSchema is the schema of `edge_points`, edge points are added or removed
+// in place instead of recreating the cloud router.
+func sdnCloudRouterInlineEdgePointSchema() map[string]*schema.Schema {
+	pointSchema := sdnCloudRouterEdgePointSchema()
+	for _, v := range pointSchema {
+		v.ForceNew = false
+	}
+	return pointSchema
+}
+
+// cloudRouterEdgePointComputedKeys are the optional arguments of edge point which are computed when not configured.
+var cloudRouterEdgePointComputedKeys = []string{"point_name", "datacenter", "vlan_id", "ip_address", "bgp_peer_ip", "bgp_password"}
+
+// cloudRouterEdgePointIdentityKeys are the arguments of edge point which can not be modified through the API.
+var cloudRouterEdgePointIdentityKeys = []string{"point_name", "point_type", "datacenter", "port_id", "vpc_id", "cloud_region", "cloud_account", "vlan_id", "route_type"}
+
+// cloudRouterEdgePointModifiableKeys are the arguments of edge point which are modified by ModifyCloudRouterEdgePoint.
+var cloudRouterEdgePointModifiableKeys = []string{"bandwidth", "ip_address", "bgp_asn", "bgp_peer_ip", "bgp_password", "static_routes"}
+
+// expandCloudRouterInlineEdgePoints returns the configured edge points. The computed arguments not configured are reset,
+// since the planned values of them are taken from the edge point at the same index, which may be another one.
+func expandCloudRouterInlineEdgePoints(d *schema.ResourceData) []map[string]interface{} {
+	raw := d.GetRawConfig()
+	configured := func(i int, k string) bool {
+		if raw.IsNull() || !raw.IsKnown() {
+			return true
+		}
+		rawPoints := raw.GetAttr("edge_points")
+		if rawPoints.IsNull() || !rawPoints.IsKnown() || i >= rawPoints.LengthInt() {
+			return true
+		}
+		return !rawPoints.AsValueSlice()[i].GetAttr(k).IsNull()
+	}
+
+	var points []map[string]interface{}
+	for i, v := range d.Get("edge_points").([]interface{}) {
+		point := make(map[string]interface{})
+		for k, value := range v.(map[string]interface{}) {
+			point[k] = value
+		}
+		point["point_id"] = ""
+		for _, k := range cloudRouterEdgePointComputedKeys {
+			if !configured(i, k) {
+				point[k] = reflect.Zero(reflect.TypeOf(point[k])).Interface()
+			}
+		}
+		points = append(points, point)
+	}
+	return points
+}
+
+// matchCloudRouterEdgePoints matches the configured edge points with the existing ones by the arguments
+// which can not be modified, returns the index of the existing edge point for each configured one, or -1 if not found.
+func matchCloudRouterEdgePoints(points []map[string]interface{}, existing []interface{}) []int {
+	matched := make([]int, len(points))
+	used := make([]bool, len(existing))
+	for i, point := range points {
+		matched[i] = -1
+		for j, v := range existing {
+			if !used[j] && isSameCloudRouterEdgePoint(point, v.(map[string]interface{})) {
+				matched[i] = j
+				used[j] = true
+				break
+			}
+		}
+	}
+	return matched
+}
+
+func isSameCloudRouterEdgePoint(point map[string]interface{}, existing map[string]interface{}) bool {
+	for _, k := range cloudRouterEdgePointIdentityKeys {
+		if v := point[k]; (v == "" || v == 0) && common2.IsContains(cloudRouterEdgePointComputedKeys, k) {
+			continue
+		}
+		if point[k] != existing[k] {
+			return false
+		}
+	}
+	return true
+}
+
+// applyCloudRouterEdgePoints deletes the edge points no longer configured, adds the new ones and modifies the
+// changed ones. It returns the edge points of the cloud router afterwards, which are in the configured order.
+func applyCloudRouterEdgePoints(ctx context.Context, sdnService *SdnService, cloudRouterId string, previous []interface{},
+	points []map[string]interface{}, timeout time.Duration) ([]interface{}, error) {
+
+	edgePointIds := make([]string, len(points))
+	matched := matchCloudRouterEdgePoints(points, previous)
+	stale := make(map[int]bool, len(previous))
+	for j := range previous {
+		stale[j] = true
+	}
+	var requests []*sdn.ModifyCloudRouterEdgePointRequest
+	for i, j := range matched {
+		if j < 0 {
+			continue
+		}
+		delete(stale, j)
+		existing := previous[j].(map[string]interface{})
+		edgePointIds[i] = existing["point_id"].(string)
+
+		point := points[i]
+		for _, k := range cloudRouterEdgePointComputedKeys {
+			if v := point[k]; v == "" || v == 0 {
+				point[k] = existing[k]
+			}
+		}
+		for _, k := range cloudRouterEdgePointModifiableKeys {
+			if !reflect.DeepEqual(point[k], existing[k]) {
+				requests = append(requests, buildModifyCloudRouterEdgePointRequest(cloudRouterId, edgePointIds[i], point))
+				break
+			}
+		}
+	}
+
+	result := func() []interface{} {
+		var result []interface{}
+		for _, edgePointId := range edgePointIds {
+			if edgePointId != "" {
+				result = append(result, map[string]interface{}{"point_id": edgePointId})
+			}
+		}
+		for j := range previous {
+			if stale[j] {
+				result = append(result, map[string]interface{}{"point_id": previous[j].(map[string]interface{})["point_id"]})
+			}
+		}
+		return result
+	}
+
+	err := func() error {
+		sdnCloudRouterMutexKV.Lock(cloudRouterId)
+		defer sdnCloudRouterMutexKV.Unlock(cloudRouterId)
+
+		// the edge points are deleted first, so that the port and VLAN of them can be taken by the new ones
+		for j := range previous {
+			if !stale[j] {
+				continue
+			}
+			edgePointId := previous[j].(map[string]interface{})["point_id"].(string)
+			if err := deleteCloudRouterEdgePoint(ctx, sdnService, cloudRouterId, edgePointId, timeout); err != nil {
+				return err
+			}
+			delete(stale, j)
+		}
+		for i, j := range matched {
+			if j >= 0 {
+				continue
+			}
+			edgePointId, err := addCloudRouterEdgePoint(ctx, sdnService, cloudRouterId, expandCloudRouterEdgePoint(points[i]), timeout)
+			if err != nil {
+				return err
+			}
+			edgePointIds[i] = edgePointId
+			if err = waitCloudRouterRunning(ctx, sdnService, cloudRouterId, timeout); err != nil {
+				return err
+			}
+		}
+		return nil
+	}()
+	if err != nil {
+		return result(), err
+	}
+
+	return result(), modifyCloudRouterEdgePoints(ctx, sdnService, cloudRouterId, requests, timeout)
+}
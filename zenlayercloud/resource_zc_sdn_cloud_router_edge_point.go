@@ -0,0 +1,602 @@
+/*
+Provides a resource to manage an edge point of layer 3 cloud router.
+
+~> **NOTE:** Edge points created inline by `edge_points` of `zenlayercloud_sdn_cloud_router` are not managed by this resource. Use this resource to add edge points to an existing cloud router without recreating it.
+
+Example Usage
+
+```hcl
+
+resource "zenlayercloud_sdn_cloud_router_edge_point" "port" {
+  cloud_router_id = "<cloudRouterId>"
+  point_name      = "port-point"
+  point_type      = "PORT"
+  port_id         = "<portId>"
+  vlan_id         = 1020
+  bandwidth       = 20
+  ip_address      = "10.10.10.1/30"
+  route_type      = "BGP"
+  bgp_asn         = 65001
+  bgp_peer_ip     = "10.10.10.2"
+  bgp_password    = "password"
+}
+
+resource "zenlayercloud_sdn_cloud_router_edge_point" "static" {
+  cloud_router_id = "<cloudRouterId>"
+  point_type      = "PORT"
+  port_id         = "<portId>"
+  vlan_id         = 1021
+  bandwidth       = 20
+  ip_address      = "10.10.20.1/30"
+  route_type      = "STATIC"
+
+  static_routes {
+    prefix   = "192.168.0.0/24"
+    next_hop = "10.10.20.2"
+  }
+}
+
+```
+
+Import
+
+Cloud router edge point can be imported using the id `cloudRouterId:edgePointId`, e.g.
+
+```
+$ terraform import zenlayercloud_sdn_cloud_router_edge_point.port cloudRouterId:edgePointId
+```
+*/
+package zenlayercloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	common2 "github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/common"
+	"github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/connectivity"
+	"github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/common"
+	sdn "github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/sdn20230830"
+)
+
+func resourceZenlayerCloudSdnCloudRouterEdgePoint() *schema.Resource {
+	pointSchema := sdnCloudRouterEdgePointSchema()
+	pointSchema["cloud_router_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "ID of the cloud router.",
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceZenlayerCloudSdnCloudRouterEdgePointCreate,
+		ReadContext:   resourceZenlayerCloudSdnCloudRouterEdgePointRead,
+		UpdateContext: resourceZenlayerCloudSdnCloudRouterEdgePointUpdate,
+		DeleteContext: resourceZenlayerCloudSdnCloudRouterEdgePointDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		CustomizeDiff: func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+			if !isCloudRouterEdgePointKnown(diff, "") {
+				return nil
+			}
+			return validateCloudRouterEdgePoint(sdnCloudRouterEdgePointValues(diff.Get))
+		},
+		Schema: pointSchema,
+	}
+}
+
+// sdnCloudRouterEdgePointSchema is shared by `edge_points` of cloud router and the edge point resource.
+func sdnCloudRouterEdgePointSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"point_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The ID of the access point.",
+		},
+		"point_name": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringLenBetween(1, 255),
+			Description:  "The name of the access point.",
+		},
+		"point_type": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(EDGE_POINT_TYPES, false),
+			Description:  "The type of the access point, Valid values: PORT, VPC, AWS, GOOGLE and TENCENT.",
+		},
+		"datacenter": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: "The ID of the datacenter where the access point located. Required when `point_type` is a cloud type.",
+		},
+		"port_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "The ID of the port. Required when `point_type` is `PORT`.",
+		},
+		"vpc_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "The ID of the VPC. Required when `point_type` is `VPC`.",
+		},
+		"cloud_region": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "Region of cloud access point. This value is available only when `point_type` within cloud type (AWS, GOOGLE and TENCENT).",
+		},
+		"cloud_account": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "The account of public cloud access point. If cloud type is GOOGLE, the value is google pairing key. This value is available only when `point_type` within cloud type (AWS, GOOGLE and TENCENT).",
+		},
+		"vlan_id": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntBetween(1, 4000),
+			Description:  "VLAN ID of the access point. Valid value ranges: [1-4000].",
+		},
+		"bandwidth": {
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "The bandwidth cap of the access point. Unit: Mbps.",
+		},
+		"ip_address": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IsCIDR,
+			Description:  "The interconnect IP address of DC within Zenlayer, in CIDR format, such as `10.10.10.1/30`.",
+		},
+		"route_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      ROUTE_TYPE_BGP,
+			ValidateFunc: validation.StringInSlice(ROUTE_TYPES, false),
+			Description:  "Type of the route, and available values include BGP and STATIC. The default value is `BGP`.",
+		},
+		"bgp_asn": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validateBgpAsn,
+			Description:  "BGP ASN of the user. Required when `route_type` is `BGP`.",
+		},
+		"bgp_peer_ip": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IsIPAddress,
+			Description:  "BGP peer IP address of the user.",
+		},
+		"bgp_password": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Sensitive:   true,
+			Description: "BGP MD5 authentication key.",
+		},
+		"bgp_local_asn": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "BGP ASN of the zenlayer. For Tencent, AWS, GOOGLE and Port, this value is 62610.",
+		},
+		"static_routes": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Static routes. Required when `route_type` is `STATIC`.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"prefix": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsCIDR,
+						Description:  "The network address to route to nextHop.",
+					},
+					"next_hop": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsIPAddress,
+						Description:  "Next Hop address.",
+					},
+				},
+			},
+		},
+		"connectivity_status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Network connectivity state. ACTIVE means the network is connected. DOWN which means not connected.",
+		},
+		"create_time": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Create time of the access point.",
+		},
+	}
+}
+
+func resourceZenlayerCloudSdnCloudRouterEdgePointCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer common2.LogElapsed(ctx, "resource.zenlayercloud_sdn_cloud_router_edge_point.create")()
+
+	sdnService := SdnService{
+		client: meta.(*connectivity.ZenlayerCloudClient),
+	}
+	cloudRouterId := d.Get("cloud_router_id").(string)
+	edgePoint := expandCloudRouterEdgePoint(sdnCloudRouterEdgePointValues(d.Get))
+
+	sdnCloudRouterMutexKV.Lock(cloudRouterId)
+	defer sdnCloudRouterMutexKV.Unlock(cloudRouterId)
+
+	edgePointId, err := addCloudRouterEdgePoint(ctx, &sdnService, cloudRouterId, edgePoint, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(fmt.Sprintf("%s:%s", cloudRouterId, edgePointId))
+
+	if err = waitCloudRouterRunning(ctx, &sdnService, cloudRouterId, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceZenlayerCloudSdnCloudRouterEdgePointRead(ctx, d, meta)
+}
+
+func resourceZenlayerCloudSdnCloudRouterEdgePointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer common2.LogElapsed(ctx, "resource.zenlayercloud_sdn_cloud_router_edge_point.read")()
+
+	var diags diag.Diagnostics
+
+	cloudRouterId, edgePointId, err := parseCloudRouterEdgePointId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sdnService := SdnService{
+		client: meta.(*connectivity.ZenlayerCloudClient),
+	}
+
+	var cloudRouter *sdn.CloudRouter
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutRead)-time.Minute, func() *resource.RetryError {
+		var errRet error
+		cloudRouter, errRet = sdnService.DescribeCloudRouterById(ctx, cloudRouterId)
+		if errRet != nil {
+			return common2.RetryError(ctx, errRet)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	point := findCloudRouterEdgePoint(cloudRouter, edgePointId)
+	if point == nil {
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("cloud_router_id", cloudRouterId)
+	for k, v := range flattenCloudRouterEdgePoint(point) {
+		_ = d.Set(k, v)
+	}
+	return diags
+}
+
+func resourceZenlayerCloudSdnCloudRouterEdgePointUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer common2.LogElapsed(ctx, "resource.zenlayercloud_sdn_cloud_router_edge_point.update")()
+
+	cloudRouterId, edgePointId, err := parseCloudRouterEdgePointId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sdnService := SdnService{
+		client: meta.(*connectivity.ZenlayerCloudClient),
+	}
+	request := buildModifyCloudRouterEdgePointRequest(cloudRouterId, edgePointId, sdnCloudRouterEdgePointValues(d.Get))
+	err = modifyCloudRouterEdgePoints(ctx, &sdnService, cloudRouterId, []*sdn.ModifyCloudRouterEdgePointRequest{request}, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceZenlayerCloudSdnCloudRouterEdgePointRead(ctx, d, meta)
+}
+
+func resourceZenlayerCloudSdnCloudRouterEdgePointDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer common2.LogElapsed(ctx, "resource.zenlayercloud_sdn_cloud_router_edge_point.delete")()
+
+	cloudRouterId, edgePointId, err := parseCloudRouterEdgePointId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sdnService := SdnService{
+		client: meta.(*connectivity.ZenlayerCloudClient),
+	}
+
+	sdnCloudRouterMutexKV.Lock(cloudRouterId)
+	defer sdnCloudRouterMutexKV.Unlock(cloudRouterId)
+
+	if err = deleteCloudRouterEdgePoint(ctx, &sdnService, cloudRouterId, edgePointId, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// addCloudRouterEdgePoint adds the edge point to the cloud router, the caller should hold the lock of the cloud router.
+func addCloudRouterEdgePoint(ctx context.Context, sdnService *SdnService, cloudRouterId string, edgePoint *sdn.CreateCloudRouterEdgePoint, timeout time.Duration) (edgePointId string, err error) {
+	err = resource.RetryContext(ctx, timeout-time.Minute, func() *resource.RetryError {
+		var errRet error
+		edgePointId, errRet = sdnService.AddCloudRouterEdgePoint(ctx, cloudRouterId, edgePoint)
+		if errRet != nil {
+			return common2.RetryError(ctx, errRet, common2.InternalServerError, common.NetworkError)
+		}
+		return nil
+	})
+	return
+}
+
+// deleteCloudRouterEdgePoint deletes the edge point and waits the cloud router to be running,
+// the caller should hold the lock of the cloud router.
+func deleteCloudRouterEdgePoint(ctx context.Context, sdnService *SdnService, cloudRouterId string, edgePointId string, timeout time.Duration) error {
+	err := resource.RetryContext(ctx, timeout-time.Minute, func() *resource.RetryError {
+		cloudRouter, errRet := sdnService.DescribeCloudRouterById(ctx, cloudRouterId)
+		if errRet != nil {
+			return common2.RetryError(ctx, errRet, common2.InternalServerError)
+		}
+		if findCloudRouterEdgePoint(cloudRouter, edgePointId) == nil {
+			return nil
+		}
+		errRet = sdnService.DeleteCloudRouterEdgePoint(ctx, cloudRouterId, edgePointId)
+		if errRet != nil {
+			return common2.RetryError(ctx, errRet, common2.InternalServerError, common.NetworkError)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return waitCloudRouterRunning(ctx, sdnService, cloudRouterId, timeout)
+}
+
+// modifyCloudRouterEdgePoints applies the edge point changes one by one, waiting the cloud router
+// to be running after each change.
+func modifyCloudRouterEdgePoints(ctx context.Context, sdnService *SdnService, cloudRouterId string, requests []*sdn.ModifyCloudRouterEdgePointRequest, timeout time.Duration) error {
+	sdnCloudRouterMutexKV.Lock(cloudRouterId)
+	defer sdnCloudRouterMutexKV.Unlock(cloudRouterId)
+
+	for _, request := range requests {
+		err := resource.RetryContext(ctx, timeout-time.Minute, func() *resource.RetryError {
+			errRet := sdnService.ModifyCloudRouterEdgePoint(ctx, request)
+			if errRet != nil {
+				return common2.RetryError(ctx, errRet, common2.InternalServerError, common.NetworkError)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if err = waitCloudRouterRunning(ctx, sdnService, cloudRouterId, timeout); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func waitCloudRouterRunning(ctx context.Context, sdnService *SdnService, cloudRouterId string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			SdnStatusCreating,
+			SdnStatusUpdating,
+		},
+		Target: []string{
+			SdnStatusRunning,
+		},
+		Refresh:        sdnService.CloudRouterStateRefreshFunc(ctx, cloudRouterId, []string{}),
+		Timeout:        timeout - time.Minute,
+		Delay:          10 * time.Second,
+		MinTimeout:     5 * time.Second,
+		NotFoundChecks: 3,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for cloud router (%s) to be running: %v", cloudRouterId, err)
+	}
+	return nil
+}
+
+// sdnCloudRouterEdgePointValues collects the edge point arguments through the getter of
+// schema.ResourceData or schema.ResourceDiff.
+func sdnCloudRouterEdgePointValues(get func(string) interface{}) map[string]interface{} {
+	values := make(map[string]interface{})
+	for k := range sdnCloudRouterEdgePointSchema() {
+		values[k] = get(k)
+	}
+	return values
+}
+
+// isCloudRouterEdgePointKnown reports whether the arguments used by validateCloudRouterEdgePoint are known at plan time.
+func isCloudRouterEdgePointKnown(diff *schema.ResourceDiff, prefix string) bool {
+	for _, k := range []string{"point_type", "port_id", "vpc_id", "cloud_region", "cloud_account", "route_type", "bgp_asn", "static_routes"} {
+		if !diff.NewValueKnown(prefix + k) {
+			return false
+		}
+	}
+	return true
+}
+
+// validateBgpAsn accepts 4-byte ASNs, the value is compared as int64 since int is 32 bits on some platforms.
+func validateBgpAsn(i interface{}, k string) (warnings []string, errs []error) {
+	v, ok := i.(int)
+	if !ok {
+		errs = append(errs, fmt.Errorf("expected type of %s to be integer", k))
+		return
+	}
+	if asn := int64(v); asn < 1 || asn > math.MaxUint32 {
+		errs = append(errs, fmt.Errorf("expected %s to be in the range (1 - %d), got %d", k, uint32(math.MaxUint32), asn))
+	}
+	return
+}
+
+func validateCloudRouterEdgePoint(point map[string]interface{}) error {
+	pointType := point["point_type"].(string)
+	switch pointType {
+	case POINT_TYPE_PORT:
+		if point["port_id"].(string) == "" {
+			return errors.New("port_id is required when point_type is PORT")
+		}
+	case POINT_TYPE_VPC:
+		if point["vpc_id"].(string) == "" {
+			return errors.New("vpc_id is required when point_type is VPC")
+		}
+	case POINT_TYPE_AWS, POINT_TYPE_GOOGLE, POINT_TYPE_TENCENT:
+		if point["cloud_region"].(string) == "" || point["cloud_account"].(string) == "" {
+			return fmt.Errorf("cloud_region and cloud_account are required when point_type is %s", pointType)
+		}
+	}
+
+	staticRoutes, _ := point["static_routes"].([]interface{})
+	if point["route_type"].(string) == ROUTE_TYPE_STATIC {
+		if len(staticRoutes) == 0 {
+			return errors.New("static_routes is required when route_type is STATIC")
+		}
+		if point["bgp_asn"].(int) != 0 {
+			return errors.New("bgp_asn cannot be configured when route_type is STATIC")
+		}
+		return nil
+	}
+	if len(staticRoutes) > 0 {
+		return errors.New("static_routes cannot be configured when route_type is BGP")
+	}
+	if point["bgp_asn"].(int) == 0 {
+		return errors.New("bgp_asn is required when route_type is BGP")
+	}
+	return nil
+}
+
+func expandCloudRouterEdgePoint(point map[string]interface{}) *sdn.CreateCloudRouterEdgePoint {
+	pointType := point["point_type"].(string)
+	edgePoint := &sdn.CreateCloudRouterEdgePoint{
+		EdgePointName: point["point_name"].(string),
+		DcId:          point["datacenter"].(string),
+		VlanId:        point["vlan_id"].(int),
+		BandwidthMbps: point["bandwidth"].(int),
+		IpAddress:     point["ip_address"].(string),
+	}
+	switch pointType {
+	case POINT_TYPE_PORT:
+		edgePoint.PortId = point["port_id"].(string)
+	case POINT_TYPE_VPC:
+		edgePoint.VpcId = point["vpc_id"].(string)
+	default:
+		edgePoint.CloudType = pointType
+		edgePoint.CloudRegionId = point["cloud_region"].(string)
+		edgePoint.CloudAccountId = point["cloud_account"].(string)
+	}
+	edgePoint.BgpConnection, edgePoint.StaticRoutes = expandCloudRouterEdgePointRoute(point)
+	return edgePoint
+}
+
+func buildModifyCloudRouterEdgePointRequest(cloudRouterId string, edgePointId string, point map[string]interface{}) *sdn.ModifyCloudRouterEdgePointRequest {
+	request := sdn.NewModifyCloudRouterEdgePointRequest()
+	request.CloudRouterId = cloudRouterId
+	request.EdgePointId = edgePointId
+	request.BandwidthMbps = point["bandwidth"].(int)
+	request.IpAddress = point["ip_address"].(string)
+	request.BgpConnection, request.StaticRoutes = expandCloudRouterEdgePointRoute(point)
+	return request
+}
+
+func expandCloudRouterEdgePointRoute(point map[string]interface{}) (*sdn.BGPConnection, []*sdn.IPRoute) {
+	if point["route_type"].(string) == ROUTE_TYPE_BGP {
+		return &sdn.BGPConnection{
+			PeerAsn:       int64(point["bgp_asn"].(int)),
+			PeerIpAddress: point["bgp_peer_ip"].(string),
+			Password:      point["bgp_password"].(string),
+		}, nil
+	}
+
+	staticRoutes, _ := point["static_routes"].([]interface{})
+	routes := make([]*sdn.IPRoute, 0, len(staticRoutes))
+	for _, v := range staticRoutes {
+		route := v.(map[string]interface{})
+		routes = append(routes, &sdn.IPRoute{
+			Prefix:  route["prefix"].(string),
+			NextHop: route["next_hop"].(string),
+		})
+	}
+	return nil, routes
+}
+
+func flattenCloudRouterEdgePoint(point *sdn.CloudRouterEdgePoint) map[string]interface{} {
+	m := map[string]interface{}{
+		"point_id":            point.EdgePointId,
+		"point_name":          point.EdgePointName,
+		"point_type":          point.EdgePointType,
+		"port_id":             point.PortId,
+		"vpc_id":              point.VpcId,
+		"cloud_region":        point.CloudRegionId,
+		"cloud_account":       point.CloudAccountId,
+		"vlan_id":             point.VlanId,
+		"bandwidth":           point.BandwidthMbps,
+		"ip_address":          point.IpAddress,
+		"connectivity_status": point.ConnectivityStatus,
+		"create_time":         point.CreateTime,
+		"static_routes":       mappingStaticRoutes(point.StaticRoutes),
+	}
+	if point.DataCenter != nil {
+		m["datacenter"] = point.DataCenter.DcId
+	}
+	if point.BgpConnection != nil {
+		m["route_type"] = ROUTE_TYPE_BGP
+		m["bgp_asn"] = int(point.BgpConnection.PeerAsn)
+		m["bgp_local_asn"] = int(point.BgpConnection.LocalAsn)
+		m["bgp_peer_ip"] = point.BgpConnection.PeerIpAddress
+		m["bgp_password"] = point.BgpConnection.Password
+	} else {
+		m["route_type"] = ROUTE_TYPE_STATIC
+	}
+	return m
+}
+
+func findCloudRouterEdgePoint(cloudRouter *sdn.CloudRouter, edgePointId string) *sdn.CloudRouterEdgePoint {
+	if cloudRouter == nil {
+		return nil
+	}
+	for _, point := range cloudRouter.EdgePoints {
+		if point.EdgePointId == edgePointId {
+			return point
+		}
+	}
+	return nil
+}
+
+func parseCloudRouterEdgePointId(id string) (cloudRouterId string, edgePointId string, err error) {
+	parts, err := common2.ParseResourceId(id, 2)
+	if err != nil {
+		return "", "", err
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,78 @@
+package zenlayercloud
+
+import (
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestValidateCloudRouterEdgePoint(t *testing.T) {
+	newPoint := func(overrides map[string]interface{}) map[string]interface{} {
+		point := map[string]interface{}{
+			"point_type":    POINT_TYPE_PORT,
+			"port_id":       "port-1",
+			"vpc_id":        "",
+			"cloud_region":  "",
+			"cloud_account": "",
+			"route_type":    ROUTE_TYPE_BGP,
+			"bgp_asn":       65001,
+			"static_routes": []interface{}{},
+		}
+		for k, v := range overrides {
+			point[k] = v
+		}
+		return point
+	}
+	staticRoutes := []interface{}{map[string]interface{}{"prefix": "10.0.0.0/24", "next_hop": "10.0.1.1"}}
+
+	cases := []struct {
+		name      string
+		point     map[string]interface{}
+		expectErr bool
+	}{
+		{"bgp port", newPoint(nil), false},
+		{"missing port id", newPoint(map[string]interface{}{"port_id": ""}), true},
+		{"missing vpc id", newPoint(map[string]interface{}{"point_type": POINT_TYPE_VPC}), true},
+		{"missing cloud account", newPoint(map[string]interface{}{"point_type": POINT_TYPE_AWS, "cloud_region": "eu-west-1"}), true},
+		{"missing bgp asn", newPoint(map[string]interface{}{"bgp_asn": 0}), true},
+		{"bgp with static routes", newPoint(map[string]interface{}{"static_routes": staticRoutes}), true},
+		{"static", newPoint(map[string]interface{}{"route_type": ROUTE_TYPE_STATIC, "bgp_asn": 0, "static_routes": staticRoutes}), false},
+		{"static without routes", newPoint(map[string]interface{}{"route_type": ROUTE_TYPE_STATIC, "bgp_asn": 0}), true},
+	}
+	for _, c := range cases {
+		err := validateCloudRouterEdgePoint(c.point)
+		if (err != nil) != c.expectErr {
+			t.Errorf("%s: expectErr %v, got %v", c.name, c.expectErr, err)
+		}
+	}
+}
+
+func TestParseCloudRouterEdgePointId(t *testing.T) {
+	cloudRouterId, edgePointId, err := parseCloudRouterEdgePointId("cr-1:ep-1")
+	if err != nil || cloudRouterId != "cr-1" || edgePointId != "ep-1" {
+		t.Errorf("unexpected result: %s, %s, %v", cloudRouterId, edgePointId, err)
+	}
+	if _, _, err = parseCloudRouterEdgePointId("cr-1"); err == nil {
+		t.Errorf("expected error for invalid id")
+	}
+}
+
+func TestValidateBgpAsn(t *testing.T) {
+	cases := map[int]bool{
+		-1:    true,
+		0:     true,
+		1:     false,
+		65000: false,
+	}
+	if strconv.IntSize == 64 {
+		maxAsn := int64(math.MaxUint32)
+		cases[int(maxAsn)] = false
+		cases[int(maxAsn+1)] = true
+	}
+	for asn, expectErr := range cases {
+		_, errs := validateBgpAsn(asn, "bgp_asn")
+		if (len(errs) > 0) != expectErr {
+			t.Errorf("bgp_asn %d: expectErr %v, got %v", asn, expectErr, errs)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package zenlayercloud
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestMatchCloudRouterEdgePoints(t *testing.T) {
+	newPoint := func(id string, portId string, vlanId int) map[string]interface{} {
+		return map[string]interface{}{
+			"point_id":      id,
+			"point_name":    "point-" + portId,
+			"point_type":    POINT_TYPE_PORT,
+			"datacenter":    "dc-1",
+			"port_id":       portId,
+			"vpc_id":        "",
+			"cloud_region":  "",
+			"cloud_account": "",
+			"vlan_id":       vlanId,
+			"route_type":    ROUTE_TYPE_BGP,
+		}
+	}
+	existing := []interface{}{newPoint("ep-1", "port-1", 100), newPoint("ep-2", "port-2", 200)}
+	unset := newPoint("", "port-2", 0)
+	unset["point_name"], unset["datacenter"] = "", ""
+
+	cases := []struct {
+		name   string
+		points []map[string]interface{}
+		expect []int
+	}{
+		{"same", []map[string]interface{}{newPoint("", "port-1", 100), newPoint("", "port-2", 200)}, []int{0, 1}},
+		{"reordered", []map[string]interface{}{newPoint("", "port-2", 200), newPoint("", "port-1", 100)}, []int{1, 0}},
+		{"appended", []map[string]interface{}{newPoint("", "port-1", 100), newPoint("", "port-2", 200), newPoint("", "port-3", 300)}, []int{0, 1, -1}},
+		{"removed", []map[string]interface{}{newPoint("", "port-2", 200)}, []int{1}},
+		{"vlan changed", []map[string]interface{}{newPoint("", "port-1", 101)}, []int{-1}},
+		{"computed arguments not configured", []map[string]interface{}{unset}, []int{1}},
+	}
+	for _, c := range cases {
+		if matched := matchCloudRouterEdgePoints(c.points, existing); !reflect.DeepEqual(matched, c.expect) {
+			t.Errorf("%s: expect %v, got %v", c.name, c.expect, matched)
+		}
+	}
+}
+
+func TestSdnCloudRouterEdgePointsInPlace(t *testing.T) {
+	state := &terraform.InstanceState{ID: "cr-1", Attributes: map[string]string{
+		"id":                            "cr-1",
+		"cr_name":                       "Terraform-Cloud-Router",
+		"force_delete":                  "false",
+		"edge_points.#":                 "1",
+		"edge_points.0.point_id":        "ep-1",
+		"edge_points.0.point_type":      POINT_TYPE_PORT,
+		"edge_points.0.port_id":         "port-1",
+		"edge_points.0.vlan_id":         "100",
+		"edge_points.0.bandwidth":       "20",
+		"edge_points.0.route_type":      ROUTE_TYPE_BGP,
+		"edge_points.0.bgp_asn":         "65001",
+		"edge_points.0.point_name":      "point-1",
+		"edge_points.0.datacenter":      "dc-1",
+		"edge_points.0.ip_address":      "10.0.0.1/30",
+		"edge_points.0.bgp_peer_ip":     "10.0.0.2",
+		"edge_points.0.bgp_password":    "",
+		"edge_points.0.static_routes.#": "0",
+	}}
+	point := func(portId string, vlanId int) map[string]interface{} {
+		return map[string]interface{}{
+			"point_type": POINT_TYPE_PORT,
+			"port_id":    portId,
+			"vlan_id":    vlanId,
+			"bandwidth":  20,
+			"bgp_asn":    65001,
+		}
+	}
+	cases := []struct {
+		name   string
+		points []interface{}
+	}{
+		{"prepended", []interface{}{point("port-2", 200), point("port-1", 100)}},
+		{"replaced", []interface{}{point("port-2", 200)}},
+	}
+	for _, c := range cases {
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{"edge_points": c.points})
+		diff, err := resourceZenlayerCloudSdnCloudRouter().SimpleDiff(context.Background(), state, config, nil)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", c.name, err)
+			continue
+		}
+		if diff == nil || diff.RequiresNew() {
+			t.Errorf("%s: expect the edge points to be changed in place, got %v", c.name, diff)
+		}
+	}
+}
+
+func TestResourceZenlayerCloudSdnCloudRouterImport(t *testing.T) {
+	cases := []struct {
+		id        string
+		pointIds  []string
+		expectErr bool
+	}{
+		{id: "cr-1"},
+		{id: "cr-1:ep-1,ep-2", pointIds: []string{"ep-1", "ep-2"}},
+		{id: "cr-1:", expectErr: true},
+		{id: ":ep-1", expectErr: true},
+	}
+	for _, c := range cases {
+		d := schema.TestResourceDataRaw(t, resourceZenlayerCloudSdnCloudRouter().Schema, map[string]interface{}{})
+		d.SetId(c.id)
+		_, err := resourceZenlayerCloudSdnCloudRouterImport(context.Background(), d, nil)
+		if (err != nil) != c.expectErr {
+			t.Errorf("%s: expect error %v, got %v", c.id, c.expectErr, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if d.Id() != "cr-1" {
+			t.Errorf("%s: expect id cr-1, got %s", c.id, d.Id())
+		}
+		var pointIds []string
+		for _, v := range d.Get("edge_points").([]interface{}) {
+			pointIds = append(pointIds, v.(map[string]interface{})["point_id"].(string))
+		}
+		if !reflect.DeepEqual(pointIds, c.pointIds) {
+			t.Errorf("%s: expect edge points %v, got %v", c.id, c.pointIds, pointIds)
+		}
+	}
+}
@@ -10,9 +10,12 @@ resource "zenlayercloud_sdn_port" "foo" {
   remarks				= "Test"
   port_type				= "1G"
   business_entity_name  = "John"
+  wait_for_loa			= true
 }
 ```
 
+~> **NOTE:** A port which is still used by private connects can't be deleted. Delete the private connects first.
+
 Import
 
 Port can be imported, e.g.
@@ -35,6 +38,7 @@ import (
 	"github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/connectivity"
 	"github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/common"
 	sdn "github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/sdn20230830"
+	"strings"
 	"time"
 )
 
@@ -49,6 +53,10 @@ func resourceZenlayerCloudDcPorts() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -86,12 +94,24 @@ func resourceZenlayerCloudDcPorts() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(2, 255),
 				Description:  "Your business entity name. The entity name to be used on the Letter of Authorization (LOA).",
 			},
+			"wait_for_loa": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicate whether to wait for the LOA status to be `AVAILABLE` when the port is created. Default is `false`. The LOA can be downloaded by `zenlayercloud_sdn_port_loa`.",
+			},
 			"force_delete": {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Default:     false,
 				Description: "Indicate whether to force delete the port. Default is `false`. If set true, the port will be permanently deleted instead of being moved into the recycle bin.",
 			},
+			"deletion_protection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicate whether to protect the port from being deleted by Terraform. Default is `false`. Set it to `false` and apply before destroying the port.",
+			},
 			"datacenter_name": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -100,7 +120,7 @@ func resourceZenlayerCloudDcPorts() *schema.Resource {
 			"loa_status": {
 				Type:        schema.TypeString,
 				Computed:    true,
-				Description: "The LOA state.",
+				Description: "The LOA state. The LOA can be downloaded when it is `AVAILABLE`.",
 			},
 			"loa_url": {
 				Type:        schema.TypeString,
@@ -134,6 +154,11 @@ func resourceZenlayerCloudDcPorts() *schema.Resource {
 func resourceZenlayerCloudDcPortsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer common2.LogElapsed(ctx, "resource.zenlayercloud_sdn_port.delete")()
 
+	portId := d.Id()
+	if d.Get("deletion_protection").(bool) {
+		return diag.Errorf("port %s is protected by `deletion_protection`, set it to `false` and apply before deleting the port", portId)
+	}
+
 	// force_delete: terminate and then delete
 	forceDelete := d.Get("force_delete").(bool)
 
@@ -141,8 +166,28 @@ func resourceZenlayerCloudDcPortsDelete(ctx context.Context, d *schema.ResourceD
 		client: meta.(*connectivity.ZenlayerCloudClient),
 	}
 
-	portId := d.Id()
+	// the port is not deleted unless all the private connects are listed successfully
+	var connects []*sdn.PrivateConnect
 	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete)-time.Minute, func() *resource.RetryError {
+		var errRet error
+		connects, errRet = sdnService.DescribePortPrivateConnects(portId)
+		if errRet != nil {
+			return common2.RetryError(ctx, errRet, common2.InternalServerError, common.NetworkError)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.Errorf("fail to check the private connects of port %s: %v", portId, err)
+	}
+	if len(connects) > 0 {
+		names := make([]string, 0, len(connects))
+		for _, connect := range connects {
+			names = append(names, fmt.Sprintf("%s(%s)", connect.PrivateConnectId, connect.PrivateConnectName))
+		}
+		return diag.Errorf("port %s is still used by private connects: %s, delete them before deleting the port", portId, strings.Join(names, ", "))
+	}
+
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete)-time.Minute, func() *resource.RetryError {
 		errRet := sdnService.DeletePortById(ctx, portId)
 		if errRet != nil {
 			return common2.RetryError(ctx, errRet)
@@ -300,6 +345,22 @@ func resourceZenlayerCloudDcPortsCreate(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(fmt.Errorf("error waiting for port (%s) to be created: %v", d.Id(), err))
 	}
 
+	if d.Get("wait_for_loa").(bool) {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate)-time.Minute, func() *resource.RetryError {
+			portInfo, errRet := sdnService.DescribePortById(ctx, portId)
+			if errRet != nil {
+				return common2.RetryError(ctx, errRet, common2.InternalServerError)
+			}
+			if portInfo == nil || portInfo.LoaStatus != SdnLoaStatusAvailable || portInfo.LoaDownloadUrl == "" {
+				return resource.RetryableError(fmt.Errorf("waiting for LOA of port %s to be available", portId))
+			}
+			return nil
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceZenlayerCloudDcPortsRead(ctx, d, meta)
 }
 
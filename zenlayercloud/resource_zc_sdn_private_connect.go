@@ -40,6 +40,39 @@ resource "zenlayercloud_sdn_private_connect" "aws-tencent-test" {
   }
 ```
 
+~> **NOTE:** When an endpoint connects to a cloud, `connect_bandwidth` is validated at plan time against the bandwidths offered by the cloud access point. Use `zenlayercloud_sdn_private_connect_price` to preview the price of a bandwidth before apply.
+
+Wait for the cloud side to accept the connection
+
+```hcl
+
+resource "zenlayercloud_sdn_private_connect" "aws-port-active" {
+  connect_name      = "Test"
+  connect_bandwidth = 20
+  wait_for_active   = true
+  endpoints {
+    port_id       = "xxxxxxxxx"
+    endpoint_type = "PORT"
+    vlan_id       = "1020"
+  }
+  endpoints {
+    datacenter    = "SOF1"
+    cloud_region  = "eu-west-1"
+    cloud_account = "123412341234"
+    endpoint_type = "AWS"
+    vlan_id       = "1458"
+  }
+
+  timeouts {
+    create = "60m"
+  }
+}
+
+output "aws_endpoint" {
+  value = zenlayercloud_sdn_private_connect.aws-port-active.endpoints[1]
+}
+```
+
 Import
 
 Private Connect can be imported, e.g.
@@ -62,6 +95,7 @@ import (
 	"github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/connectivity"
 	"github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/common"
 	sdn "github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/sdn20230830"
+	"sort"
 	"time"
 )
 
@@ -76,6 +110,13 @@ func resourceZenlayerCloudPrivateConnect() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: privateConnectBandwidthValidFunc,
+
 		Schema: map[string]*schema.Schema{
 			"connect_name": {
 				Type:         schema.TypeString,
@@ -89,7 +130,7 @@ func resourceZenlayerCloudPrivateConnect() *schema.Resource {
 				Optional:     true,
 				Default:      1,
 				ValidateFunc: validation.IntBetween(1, 500),
-				Description:  "The bandwidth of private connect. Valid range: [1,500]. Unit: Mbps. Default is `1`.",
+				Description:  "The bandwidth of private connect. Valid range: [1,500]. Unit: Mbps. Default is `1`. If any endpoint connects to a cloud, the value must be one of the bandwidths offered by the cloud access point.",
 			},
 			"endpoints": {
 				Type:        schema.TypeList,
@@ -117,6 +158,11 @@ func resourceZenlayerCloudPrivateConnect() *schema.Resource {
 							ForceNew:    true,
 							Description: "The account of public cloud access point. If cloud type is GOOGLE, the value is google pairing key. This value is available only when `endpoint_type` within cloud type (AWS, GOOGLE and TENCENT).",
 						},
+						"endpoint_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the access point.",
+						},
 						"endpoint_name": {
 							Type:        schema.TypeString,
 							Computed:    true,
@@ -149,12 +195,24 @@ func resourceZenlayerCloudPrivateConnect() *schema.Resource {
 					},
 				},
 			},
+			"wait_for_active": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicate whether to wait for `connectivity_status` to be `ACTIVE` after the private connect is created. Default is `false`. A connection to a cloud becomes active only after it is accepted on the cloud side, so only enable it when the connection is accepted outside of this apply, or set it to `true` on a later apply to wait for the acceptance.",
+			},
 			"force_delete": {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Default:     false,
 				Description: "Indicate whether to force delete the private connect. Default is `false`. If set true, the private connect will be permanently deleted instead of being moved into the recycle bin.",
 			},
+			"deletion_protection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicate whether to protect the private connect from being deleted by Terraform. Default is `false`. Set it to `false` and apply before destroying the private connect.",
+			},
 			"connectivity_status": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -192,6 +250,11 @@ func resourceZenlayerCloudPrivateConnect() *schema.Resource {
 func resourceZenlayerCloudPrivateConnectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer common2.LogElapsed(ctx, "resource.zenlayercloud_sdn_private_connect.delete")()
 
+	connectId := d.Id()
+	if d.Get("deletion_protection").(bool) {
+		return diag.Errorf("private connect %s is protected by `deletion_protection`, set it to `false` and apply before deleting the private connect", connectId)
+	}
+
 	// force_delete: terminate and then delete
 	forceDelete := d.Get("force_delete").(bool)
 
@@ -199,7 +262,6 @@ func resourceZenlayerCloudPrivateConnectDelete(ctx context.Context, d *schema.Re
 		client: meta.(*connectivity.ZenlayerCloudClient),
 	}
 
-	connectId := d.Id()
 	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete)-time.Minute, func() *resource.RetryError {
 		errRet := sdnService.DeletePrivateConnectById(ctx, connectId)
 		if errRet != nil {
@@ -304,6 +366,12 @@ func resourceZenlayerCloudPrivateConnectUpdate(ctx context.Context, d *schema.Re
 		}
 	}
 
+	if d.HasChange("wait_for_active") && d.Get("wait_for_active").(bool) {
+		if err := waitPrivateConnectActive(ctx, &sdnService, connectId, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceZenlayerCloudPrivateConnectRead(ctx, d, meta)
 }
 
@@ -373,9 +441,215 @@ func resourceZenlayerCloudPrivateConnectCreate(ctx context.Context, d *schema.Re
 		return diag.FromErr(fmt.Errorf("error waiting for connect (%s) to be created: %v", d.Id(), err))
 	}
 
+	if d.Get("wait_for_active").(bool) {
+		if err := waitPrivateConnectActive(ctx, &sdnService, privateConnectId, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceZenlayerCloudPrivateConnectRead(ctx, d, meta)
 }
 
+func waitPrivateConnectActive(ctx context.Context, sdnService *SdnService, connectId string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			SdnConnectivityDown,
+		},
+		Target: []string{
+			SdnConnectivityActive,
+		},
+		Refresh:        sdnService.PrivateConnectConnectivityRefreshFunc(ctx, connectId),
+		Timeout:        timeout - time.Minute,
+		Delay:          10 * time.Second,
+		MinTimeout:     10 * time.Second,
+		NotFoundChecks: 3,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for connect (%s) to be active: %v", connectId, err)
+	}
+	return nil
+}
+
+// privateConnectBandwidthValidFunc checks the bandwidth against the offer of the endpoints at plan time.
+// Failures of querying the offer are only logged, the bandwidth is still validated by the API on apply.
+func privateConnectBandwidthValidFunc(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if meta == nil {
+		return nil
+	}
+	if diff.Id() != "" && !diff.HasChange("connect_bandwidth") {
+		return nil
+	}
+	if !diff.NewValueKnown("connect_bandwidth") {
+		return nil
+	}
+	endpoints := diff.Get("endpoints").([]interface{})
+	if len(endpoints) != 2 {
+		return nil
+	}
+	params := make([]sdn.CreateEndpointParam, 0, len(endpoints))
+	for i, endpoint := range endpoints {
+		for _, k := range []string{"endpoint_type", "port_id", "datacenter", "cloud_region", "cloud_account", "vlan_id"} {
+			if !diff.NewValueKnown(fmt.Sprintf("endpoints.%d.%s", i, k)) {
+				// validate it in apply stage
+				return nil
+			}
+		}
+		params = append(params, parseEndpoint(endpoint))
+	}
+
+	sdnService := SdnService{
+		client: meta.(*connectivity.ZenlayerCloudClient),
+	}
+	oldBandwidth, newBandwidth := diff.GetChange("connect_bandwidth")
+	bandwidth := newBandwidth.(int)
+
+	tiers, err := describePrivateConnectBandwidthTiers(ctx, &sdnService, params)
+	if err != nil {
+		tflog.Warn(ctx, "Fail to query available bandwidths of private connect, skip the validation.", map[string]interface{}{
+			"err": err.Error(),
+		})
+		return nil
+	}
+	if err = checkPrivateConnectBandwidth(bandwidth, tiers); err != nil {
+		return err
+	}
+
+	if params[0].PortId == "" || params[1].PortId == "" {
+		return nil
+	}
+	priceInfo, err := describePrivateConnectBandwidthPrice(ctx, &sdnService, params, bandwidth)
+	if err != nil {
+		tflog.Warn(ctx, "Fail to query bandwidth stock of private connect, skip the validation.", map[string]interface{}{
+			"err": err.Error(),
+		})
+		return nil
+	}
+	// only the increase of bandwidth takes the stock
+	return checkPrivateConnectBandwidthStock(bandwidth-oldBandwidth.(int), priceInfo)
+}
+
+// describePrivateConnectBandwidthPrice returns the price and stock of bandwidth between the datacenters of the endpoints.
+// The datacenter of a PORT endpoint is the one of the port if `datacenter` is not specified.
+func describePrivateConnectBandwidthPrice(ctx context.Context, sdnService *SdnService, endpoints []sdn.CreateEndpointParam, bandwidth int) (*sdn.QueryPrivateConnectBandwidthPriceResponseParams, error) {
+	dcIds := make([]string, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		dcId := endpoint.DcId
+		if dcId == "" && endpoint.PortId != "" {
+			var port *sdn.PortInfo
+			err := resource.RetryContext(ctx, common2.ReadRetryTimeout, func() *resource.RetryError {
+				var errRet error
+				port, errRet = sdnService.DescribePortById(ctx, endpoint.PortId)
+				if errRet != nil {
+					return common2.RetryError(ctx, errRet, common2.InternalServerError, common.NetworkError)
+				}
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			if port == nil {
+				return nil, fmt.Errorf("port %s is not found", endpoint.PortId)
+			}
+			dcId = port.DcId
+		}
+		if dcId == "" {
+			return nil, fmt.Errorf("datacenter of the endpoint is unknown")
+		}
+		dcIds = append(dcIds, dcId)
+	}
+
+	var priceInfo *sdn.QueryPrivateConnectBandwidthPriceResponseParams
+	err := resource.RetryContext(ctx, common2.ReadRetryTimeout, func() *resource.RetryError {
+		var errRet error
+		priceInfo, errRet = sdnService.QueryPrivateConnectBandwidthPrice(ctx, dcIds[0], dcIds[1], bandwidth)
+		if errRet != nil {
+			return common2.RetryError(ctx, errRet, common2.InternalServerError, common.NetworkError)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fail to query bandwidth price between %s and %s: %v", dcIds[0], dcIds[1], err)
+	}
+	return priceInfo, nil
+}
+
+// checkPrivateConnectBandwidthStock checks the additional bandwidth against the stock between datacenters,
+// nothing is checked if the stock is unknown.
+func checkPrivateConnectBandwidthStock(additionalBandwidth int, priceInfo *sdn.QueryPrivateConnectBandwidthPriceResponseParams) error {
+	if additionalBandwidth <= 0 || priceInfo == nil || priceInfo.Price == nil {
+		return nil
+	}
+	if additionalBandwidth > priceInfo.Stock {
+		return fmt.Errorf("%d Mbps more bandwidth is required but only %d Mbps is available between the datacenters of the ports", additionalBandwidth, priceInfo.Stock)
+	}
+	return nil
+}
+
+// describePrivateConnectBandwidthTiers returns the bandwidths offered by all the cloud endpoints.
+// The result is nil if none of the endpoints connects to a cloud.
+func describePrivateConnectBandwidthTiers(ctx context.Context, sdnService *SdnService, endpoints []sdn.CreateEndpointParam) ([]int, error) {
+	var tiers []int
+	for _, endpoint := range endpoints {
+		if endpoint.CloudType == "" {
+			continue
+		}
+		var endpointTiers []int
+		err := resource.RetryContext(ctx, common2.ReadRetryTimeout, func() *resource.RetryError {
+			var errRet error
+			endpointTiers, errRet = sdnService.DescribeCloudAvailableBandwidthTiers(ctx, endpoint)
+			if errRet != nil {
+				return common2.RetryError(ctx, errRet, common2.InternalServerError, common.NetworkError)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("fail to query available bandwidths of %s endpoint in %s: %v", endpoint.CloudType, endpoint.CloudRegionId, err)
+		}
+		if endpointTiers == nil {
+			// the offer of this endpoint is unknown
+			continue
+		}
+		if tiers == nil {
+			tiers = append([]int{}, endpointTiers...)
+		} else {
+			tiers = intersectBandwidthTiers(tiers, endpointTiers)
+		}
+	}
+	if tiers != nil {
+		sort.Ints(tiers)
+	}
+	return tiers, nil
+}
+
+func intersectBandwidthTiers(a, b []int) []int {
+	res := make([]int, 0, len(a))
+	for _, v := range a {
+		for _, w := range b {
+			if v == w {
+				res = append(res, v)
+				break
+			}
+		}
+	}
+	return res
+}
+
+func checkPrivateConnectBandwidth(bandwidth int, tiers []int) error {
+	if tiers == nil {
+		return nil
+	}
+	if len(tiers) == 0 {
+		return fmt.Errorf("no bandwidth is available between the endpoints of private connect")
+	}
+	for _, tier := range tiers {
+		if tier == bandwidth {
+			return nil
+		}
+	}
+	return fmt.Errorf("bandwidth %d Mbps is not supported by the endpoints of private connect, available bandwidths (Mbps): %v", bandwidth, tiers)
+}
+
 func parseEndpoint(endpointParam interface{}) sdn.CreateEndpointParam {
 	c := sdn.CreateEndpointParam{}
 
@@ -0,0 +1,81 @@
+package zenlayercloud
+
+import (
+	"reflect"
+	"testing"
+
+	sdn "github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/sdn20230830"
+)
+
+func TestIntersectBandwidthTiers(t *testing.T) {
+	got := intersectBandwidthTiers([]int{50, 100, 200, 500}, []int{100, 500, 1000})
+	if !reflect.DeepEqual(got, []int{100, 500}) {
+		t.Errorf("unexpected intersection: %v", got)
+	}
+	if got := intersectBandwidthTiers([]int{50}, []int{100}); len(got) != 0 {
+		t.Errorf("expect empty intersection, got %v", got)
+	}
+}
+
+func TestCheckPrivateConnectBandwidth(t *testing.T) {
+	cases := []struct {
+		name      string
+		bandwidth int
+		tiers     []int
+		expectErr bool
+	}{
+		{"no cloud endpoint", 30, nil, false},
+		{"supported", 100, []int{50, 100, 200}, false},
+		{"unsupported", 30, []int{50, 100, 200}, true},
+		{"no available bandwidth", 50, []int{}, true},
+	}
+	for _, c := range cases {
+		err := checkPrivateConnectBandwidth(c.bandwidth, c.tiers)
+		if (err != nil) != c.expectErr {
+			t.Errorf("%s: expect error %v, got %v", c.name, c.expectErr, err)
+		}
+	}
+}
+
+func TestFilterPortPrivateConnects(t *testing.T) {
+	portEndpoint := func(id string) sdn.PrivateConnectEndpoint {
+		return sdn.PrivateConnectEndpoint{EndpointId: id, EndpointType: POINT_TYPE_PORT}
+	}
+	cloudEndpoint := sdn.PrivateConnectEndpoint{EndpointId: "ep-aws", EndpointType: POINT_TYPE_AWS}
+	connects := []*sdn.PrivateConnect{
+		{PrivateConnectId: "pc-1", PrivateConnectStatus: SdnStatusRunning, EndpointA: portEndpoint("port-1"), EndpointZ: cloudEndpoint},
+		{PrivateConnectId: "pc-2", PrivateConnectStatus: SdnStatusRunning, EndpointA: portEndpoint("port-2"), EndpointZ: portEndpoint("port-1")},
+		{PrivateConnectId: "pc-3", PrivateConnectStatus: SdnStatusRecycle, EndpointA: portEndpoint("port-1"), EndpointZ: cloudEndpoint},
+		{PrivateConnectId: "pc-4", PrivateConnectStatus: SdnStatusRunning, EndpointA: portEndpoint("port-2"), EndpointZ: cloudEndpoint},
+	}
+
+	var ids []string
+	for _, connect := range filterPortPrivateConnects("port-1", connects) {
+		ids = append(ids, connect.PrivateConnectId)
+	}
+	if !reflect.DeepEqual(ids, []string{"pc-1", "pc-2"}) {
+		t.Errorf("unexpected private connects: %v", ids)
+	}
+}
+
+func TestCheckPrivateConnectBandwidthStock(t *testing.T) {
+	price := &sdn.Price{}
+	cases := []struct {
+		name                string
+		additionalBandwidth int
+		priceInfo           *sdn.QueryPrivateConnectBandwidthPriceResponseParams
+		expectErr           bool
+	}{
+		{"unknown stock", 100, nil, false},
+		{"no price", 100, &sdn.QueryPrivateConnectBandwidthPriceResponseParams{}, false},
+		{"decreased", -50, &sdn.QueryPrivateConnectBandwidthPriceResponseParams{Price: price}, false},
+		{"enough stock", 100, &sdn.QueryPrivateConnectBandwidthPriceResponseParams{Price: price, Stock: 100}, false},
+		{"out of stock", 100, &sdn.QueryPrivateConnectBandwidthPriceResponseParams{Price: price, Stock: 50}, true},
+	}
+	for _, c := range cases {
+		err := checkPrivateConnectBandwidthStock(c.additionalBandwidth, c.priceInfo)
+		if (err != nil) != c.expectErr {
+			t.Errorf("%s: expect error %v, got %v", c.name, c.expectErr, err)
+		}
+	}
+}
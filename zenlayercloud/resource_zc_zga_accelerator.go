@@ -5,6 +5,8 @@ Provides a accelerator resource.
 
 ~> **NOTE:** The Domain is not allowed to be the same as origin, otherwise a loop will be formed, making acceleration unusable.
 
+~> **NOTE:** Listeners, access control rules and health check can also be managed by `zenlayercloud_zga_listener`, `zenlayercloud_zga_access_control_rule` and `zenlayercloud_zga_health_check`, and the certificate by `zenlayercloud_zga_certificate_binding`. Do not configure the same part both inline and by these resources, otherwise they will overwrite each other. Add `listeners`, `access_control_rules` or `certificate` to `managed_externally` when they are managed by the standalone resources, so that the accelerator keeps them instead of removing them.
+
 Example Usage
 ```hcl
 
@@ -137,6 +139,7 @@ func resourceZenlayerCloudAccelerator() *schema.Resource {
 			PortCheckValidFunc,
 			ProtocolOptsCheckValidFunc,
 			AccessControlValidFunc,
+			CertificateDomainValidFunc,
 		),
 		Schema: map[string]*schema.Schema{
 			"accelerator_name": {
@@ -170,9 +173,10 @@ func resourceZenlayerCloudAccelerator() *schema.Resource {
 				Description: "The resource group id the accelerator belongs to, default to Default Resource Group. Modification is not supported.",
 			},
 			"certificate_id": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "The certificate of the accelerator. Required when exist https protocol accelerate.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: zgaManagedExternallySuppressFunc(ZgaManagedExternallyCertificate),
+				Description:      "The certificate of the accelerator. Required when exist https protocol accelerate. Ignored when `certificate` is in `managed_externally`.",
 			},
 			"cname": {
 				Type:        schema.TypeString,
@@ -236,9 +240,10 @@ func resourceZenlayerCloudAccelerator() *schema.Resource {
 				},
 			},
 			"l4_listeners": {
-				Type:        schema.TypeSet,
-				Description: "L4 listeners of the accelerator.",
-				Optional:    true,
+				Type:             schema.TypeSet,
+				Description:      "L4 listeners of the accelerator. Ignored when `listeners` is in `managed_externally`.",
+				Optional:         true,
+				DiffSuppressFunc: zgaManagedExternallySuppressFunc(ZgaManagedExternallyListeners),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"port": {
@@ -275,9 +280,10 @@ func resourceZenlayerCloudAccelerator() *schema.Resource {
 				},
 			},
 			"l7_listeners": {
-				Type:        schema.TypeSet,
-				Description: "L7 listeners of the accelerator.",
-				Optional:    true,
+				Type:             schema.TypeSet,
+				Description:      "L7 listeners of the accelerator. Ignored when `listeners` is in `managed_externally`.",
+				Optional:         true,
+				DiffSuppressFunc: zgaManagedExternallySuppressFunc(ZgaManagedExternallyListeners),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"port": {
@@ -324,6 +330,15 @@ func resourceZenlayerCloudAccelerator() *schema.Resource {
 					},
 				},
 			},
+			"managed_externally": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{ZgaManagedExternallyListeners, ZgaManagedExternallyAccessControlRules, ZgaManagedExternallyCertificate}, false),
+				},
+				Description: "Parts of the accelerator managed by standalone resources, the differences of which are ignored by this resource. Valid values: `listeners` (managed by `zenlayercloud_zga_listener`), `access_control_rules` (managed by `zenlayercloud_zga_access_control_rule`), `certificate` (managed by `zenlayercloud_zga_certificate_binding`).",
+			},
 			"protocol_opts": {
 				Optional:    true,
 				Computed:    true,
@@ -404,9 +419,10 @@ func resourceZenlayerCloudAccelerator() *schema.Resource {
 							Description: "Whether to enable access control. Default is `true`.",
 						},
 						"rules": {
-							Type:        schema.TypeSet,
-							Optional:    true,
-							Description: "Rules of the access control.",
+							Type:             schema.TypeSet,
+							Optional:         true,
+							DiffSuppressFunc: zgaManagedExternallySuppressFunc(ZgaManagedExternallyAccessControlRules),
+							Description:      "Rules of the access control. Ignored when `access_control_rules` is in `managed_externally`.",
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"listener": {
@@ -847,6 +863,10 @@ func resourceZenlayerCloudAcceleratorUpdate(ctx context.Context, d *schema.Resou
 		acceleratorId = d.Id()
 		zgaService    = NewZgaService(meta.(*connectivity.ZenlayerCloudClient))
 	)
+
+	zgaAcceleratorMutexKV.Lock(acceleratorId)
+	defer zgaAcceleratorMutexKV.Unlock(acceleratorId)
+
 	if d.HasChange("accelerator_name") {
 		err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate)-time.Minute, func() *resource.RetryError {
 			errRet := zgaService.ModifyAcceleratorName(ctx, acceleratorId, d.Get("accelerator_name").(string))
@@ -1213,6 +1233,11 @@ func PortCheckValidFunc(_ context.Context, diff *schema.ResourceDiff, _ interfac
 		}
 	}
 
+	if isZgaManagedExternally(diff, ZgaManagedExternallyListeners) {
+		// listeners managed by zenlayercloud_zga_listener may not exist yet
+		return nil
+	}
+
 	if len(allowAccessControlListener) == 0 {
 		return errors.New("required listeners")
 	}
@@ -1254,6 +1279,17 @@ func PortCheckValidFunc(_ context.Context, diff *schema.ResourceDiff, _ interfac
 	return nil
 }
 
+func isZgaManagedExternally(d interface{ Get(string) interface{} }, part string) bool {
+	parts, ok := d.Get("managed_externally").(*schema.Set)
+	return ok && parts.Contains(part)
+}
+
+func zgaManagedExternallySuppressFunc(part string) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		return isZgaManagedExternally(d, part)
+	}
+}
+
 func DomainAcceleratorValidFunc() schema.CustomizeDiffFunc {
 	return customdiff.IfValue("domain", func(ctx context.Context, value, meta interface{}) bool {
 		return value != ""
@@ -1273,6 +1309,22 @@ func DomainAcceleratorValidFunc() schema.CustomizeDiffFunc {
 	})
 }
 
+func CertificateDomainValidFunc(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if meta == nil || !diff.HasChanges("certificate_id", "domain", "relate_domains") {
+		return nil
+	}
+	if !diff.NewValueKnown("certificate_id") || !diff.NewValueKnown("domain") || !diff.NewValueKnown("relate_domains") {
+		return nil
+	}
+	certificateId := diff.Get("certificate_id").(string)
+	domain := diff.Get("domain").(string)
+	if certificateId == "" || domain == "" {
+		return nil
+	}
+	domains := append([]string{domain}, common2.ToStringList(diff.Get("relate_domains").(*schema.Set).List())...)
+	return checkZgaCertificateCoversDomains(ctx, NewZgaService(meta.(*connectivity.ZenlayerCloudClient)), certificateId, domains)
+}
+
 func IPAcceleratorValidFunc() schema.CustomizeDiffFunc {
 	return customdiff.IfValue("domain", func(ctx context.Context, value, meta interface{}) bool {
 		return value == ""
@@ -0,0 +1,351 @@
+/*
+Provides a resource to manage a single access control rule of accelerator.
+
+~> **NOTE:** Access control rules of an accelerator can be managed either inline by `access_control.rules` of `zenlayercloud_zga_accelerator` or by this resource, but not both, otherwise they will overwrite each other. When using this resource, add `access_control_rules` to `managed_externally` of the accelerator. Access control should be enabled by `access_control.enable` of the accelerator.
+
+Example Usage
+
+```hcl
+
+resource "zenlayercloud_zga_listener" "https" {
+  accelerator_id = "<acceleratorId>"
+  protocol       = "https"
+  port           = 443
+  back_port      = 80
+  back_protocol  = "http"
+}
+
+resource "zenlayercloud_zga_access_control_rule" "deny" {
+  accelerator_id = "<acceleratorId>"
+  listener       = "https:443"
+  directory      = "/admin"
+  policy         = "deny"
+  cidr_ip        = ["10.10.10.10", "10.10.11.0/24"]
+
+  depends_on = [zenlayercloud_zga_listener.https]
+}
+
+```
+
+Import
+
+Access control rule can be imported using the id, the id format must be '{accelerator_id}:{listener}:{directory}', e.g.
+
+```
+$ terraform import zenlayercloud_zga_access_control_rule.deny acceleratorId:https:443:/admin
+```
+*/
+package zenlayercloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	common2 "github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/common"
+	"github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/connectivity"
+	"github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/common"
+	zga "github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/zga20230706"
+)
+
+func resourceZenlayerCloudZgaAccessControlRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceZenlayerCloudZgaAccessControlRuleCreate,
+		ReadContext:   resourceZenlayerCloudZgaAccessControlRuleRead,
+		UpdateContext: resourceZenlayerCloudZgaAccessControlRuleUpdate,
+		DeleteContext: resourceZenlayerCloudZgaAccessControlRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(common2.ZgaUpdateTimeout),
+			Update: schema.DefaultTimeout(common2.ZgaUpdateTimeout),
+			Delete: schema.DefaultTimeout(common2.ZgaUpdateTimeout),
+		},
+		CustomizeDiff: zgaAccessControlRuleValidFunc,
+		Schema: map[string]*schema.Schema{
+			"accelerator_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the accelerator that the rule belongs to.",
+			},
+			"listener": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: IsAcListener,
+				Description:  "The listener of the rule. Valid values are `$protocol:$port`, `$protocol:$portRange`, `all`.",
+			},
+			"directory": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "/",
+				Description: "The directory of the rule. Not configurable with L4 listener. Default is `/`. Wildcards supported: *.",
+			},
+			"cidr_ip": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "The cidr ip of the rule.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: func(i interface{}, s string) ([]string, []error) {
+						warnings, err := validation.IsIPAddress(i, s)
+						if len(err) == 0 {
+							return warnings, err
+						}
+						return validation.IsCIDR(i, s)
+					},
+				},
+			},
+			"policy": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"accept", "deny"}, false),
+				Description:  "The policy of the rule. Valid values are `accept`, `deny`.",
+			},
+			"note": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The note of the rule.",
+			},
+		},
+	}
+}
+
+func resourceZenlayerCloudZgaAccessControlRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer common2.LogElapsed(ctx, "resource.zenlayercloud_zga_access_control_rule.create")()
+
+	var (
+		zgaService    = NewZgaService(meta.(*connectivity.ZenlayerCloudClient))
+		acceleratorId = d.Get("accelerator_id").(string)
+		listener      = d.Get("listener").(string)
+		directory     = d.Get("directory").(string)
+	)
+
+	err := modifyZgaAcceleratorAccessControlRules(ctx, zgaService, d, acceleratorId, schema.TimeoutCreate,
+		func(rules []zga.AccessControlRule) ([]zga.AccessControlRule, error) {
+			if findZgaAccessControlRule(rules, listener, directory) != -1 {
+				return nil, fmt.Errorf("access control rule of listener %s and directory %s already exists in accelerator %s", listener, directory, acceleratorId)
+			}
+			return append(rules, sharpenZgaAccessControlRule(d)), nil
+		})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strings.Join([]string{acceleratorId, listener, directory}, ":"))
+
+	return resourceZenlayerCloudZgaAccessControlRuleRead(ctx, d, meta)
+}
+
+func resourceZenlayerCloudZgaAccessControlRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var (
+		diags           diag.Diagnostics
+		zgaService      = NewZgaService(meta.(*connectivity.ZenlayerCloudClient))
+		acceleratorInfo *zga.AcceleratorInfo
+	)
+
+	acceleratorId, listener, directory, err := parseZgaAccessControlRuleId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutRead)-time.Minute, func() *resource.RetryError {
+		var errRet error
+		acceleratorInfo, errRet = zgaService.DescribeAcceleratorById(ctx, acceleratorId)
+		if errRet != nil {
+			return common2.RetryError(ctx, errRet, common2.InternalServerError)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if acceleratorInfo == nil || acceleratorInfo.AccessControl == nil {
+		d.SetId("")
+		return nil
+	}
+
+	var rule *zga.AccessControlRule
+	for _, r := range acceleratorInfo.AccessControl.Rules {
+		if r != nil && r.Listener == listener && r.Directory == directory {
+			rule = r
+			break
+		}
+	}
+	if rule == nil {
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("accelerator_id", acceleratorId)
+	_ = d.Set("listener", rule.Listener)
+	_ = d.Set("directory", rule.Directory)
+	_ = d.Set("cidr_ip", splitStringByCommaOrSemicolon(rule.CidrIp))
+	_ = d.Set("policy", rule.Policy)
+	_ = d.Set("note", rule.Note)
+	return diags
+}
+
+func resourceZenlayerCloudZgaAccessControlRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer common2.LogElapsed(ctx, "resource.zenlayercloud_zga_access_control_rule.update")()
+
+	var (
+		zgaService    = NewZgaService(meta.(*connectivity.ZenlayerCloudClient))
+		acceleratorId = d.Get("accelerator_id").(string)
+		listener      = d.Get("listener").(string)
+		directory     = d.Get("directory").(string)
+	)
+
+	if d.HasChanges("cidr_ip", "policy", "note") {
+		err := modifyZgaAcceleratorAccessControlRules(ctx, zgaService, d, acceleratorId, schema.TimeoutUpdate,
+			func(rules []zga.AccessControlRule) ([]zga.AccessControlRule, error) {
+				index := findZgaAccessControlRule(rules, listener, directory)
+				if index == -1 {
+					return nil, fmt.Errorf("access control rule of listener %s and directory %s not found in accelerator %s", listener, directory, acceleratorId)
+				}
+				rules[index] = sharpenZgaAccessControlRule(d)
+				return rules, nil
+			})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceZenlayerCloudZgaAccessControlRuleRead(ctx, d, meta)
+}
+
+func resourceZenlayerCloudZgaAccessControlRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer common2.LogElapsed(ctx, "resource.zenlayercloud_zga_access_control_rule.delete")()
+
+	zgaService := NewZgaService(meta.(*connectivity.ZenlayerCloudClient))
+
+	acceleratorId, listener, directory, err := parseZgaAccessControlRuleId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = modifyZgaAcceleratorAccessControlRules(ctx, zgaService, d, acceleratorId, schema.TimeoutDelete,
+		func(rules []zga.AccessControlRule) ([]zga.AccessControlRule, error) {
+			if index := findZgaAccessControlRule(rules, listener, directory); index != -1 {
+				rules = append(rules[:index], rules[index+1:]...)
+			}
+			return rules, nil
+		})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// modifyZgaAcceleratorAccessControlRules applies modifyFunc to the current access control rules of the
+// accelerator and writes the whole rule list back.
+func modifyZgaAcceleratorAccessControlRules(ctx context.Context, zgaService *ZgaService, d *schema.ResourceData, acceleratorId string, timeoutKey string,
+	modifyFunc func([]zga.AccessControlRule) ([]zga.AccessControlRule, error)) error {
+
+	zgaAcceleratorMutexKV.Lock(acceleratorId)
+	defer zgaAcceleratorMutexKV.Unlock(acceleratorId)
+
+	var acceleratorInfo *zga.AcceleratorInfo
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutRead)-time.Minute, func() *resource.RetryError {
+		var errRet error
+		acceleratorInfo, errRet = zgaService.DescribeAcceleratorById(ctx, acceleratorId)
+		if errRet != nil {
+			return common2.RetryError(ctx, errRet, common2.InternalServerError)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if acceleratorInfo == nil {
+		if timeoutKey == schema.TimeoutDelete {
+			return nil
+		}
+		return fmt.Errorf("accelerator %s not found", acceleratorId)
+	}
+
+	var rules []zga.AccessControlRule
+	if acceleratorInfo.AccessControl != nil {
+		rules = make([]zga.AccessControlRule, 0, len(acceleratorInfo.AccessControl.Rules))
+		for _, rule := range acceleratorInfo.AccessControl.Rules {
+			if rule != nil {
+				rules = append(rules, *rule)
+			}
+		}
+	}
+
+	rules, err = modifyFunc(rules)
+	if err != nil {
+		return err
+	}
+
+	err = resource.RetryContext(ctx, d.Timeout(timeoutKey)-time.Minute, func() *resource.RetryError {
+		errRet := zgaService.ModifyAcceleratorAccessControl(ctx, acceleratorId, rules)
+		if errRet != nil {
+			return common2.RetryError(ctx, errRet, common2.InternalServerError, common.NetworkError)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return waitAcceleratorDeploySuccess(ctx, zgaService, d, acceleratorId)
+}
+
+func sharpenZgaAccessControlRule(d *schema.ResourceData) zga.AccessControlRule {
+	return zga.AccessControlRule{
+		Listener:  d.Get("listener").(string),
+		Directory: d.Get("directory").(string),
+		CidrIp:    InterfaceSliceToString(d.Get("cidr_ip").(*schema.Set).List()),
+		Policy:    d.Get("policy").(string),
+		Note:      d.Get("note").(string),
+	}
+}
+
+func findZgaAccessControlRule(rules []zga.AccessControlRule, listener, directory string) int {
+	for i, rule := range rules {
+		if rule.Listener == listener && rule.Directory == directory {
+			return i
+		}
+	}
+	return -1
+}
+
+func zgaAccessControlRuleValidFunc(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	listener := diff.Get("listener").(string)
+	directory := diff.Get("directory").(string)
+	if (listener == ZgaAccessControlAllListener ||
+		strings.HasPrefix(listener, ZgaUDPL4Protocol+":") ||
+		strings.HasPrefix(listener, ZgaTCPL4Protocol+":")) && directory != "/" {
+		return errors.New("directory cannot be configured when listener is `all` or `tcp` or `udp`")
+	}
+	return nil
+}
+
+// parseZgaAccessControlRuleId parses id in the form of `{accelerator_id}:{listener}:{directory}`,
+// where the listener is either `all` or `$protocol:$port`.
+func parseZgaAccessControlRuleId(id string) (acceleratorId, listener, directory string, err error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 3 && len(parts) != 4 {
+		err = fmt.Errorf("invalid access control rule id %s, expected format {accelerator_id}:{listener}:{directory}", id)
+		return
+	}
+	acceleratorId = parts[0]
+	listener = strings.Join(parts[1:len(parts)-1], ":")
+	directory = parts[len(parts)-1]
+	if _, errs := IsAcListener(listener, "listener"); len(errs) > 0 {
+		err = errs[0]
+	}
+	return
+}
@@ -5,6 +5,10 @@ Provides a certificate resource.
 
 ~> **NOTE:** When the certificate and key are set to empty strings, the Update will not take effect.
 
+~> **NOTE:** The certificate and key are validated at plan time: the key must match the certificate, the chain must be ordered from leaf to root and the certificate must not be expired.
+
+~> **NOTE:** To rotate a certificate without downtime, set `create_before_destroy` and bind it to the accelerator with `zenlayercloud_zga_certificate_binding`. When `auto_rotate_before_days` is set, `ready_for_rotation` turns `true` in the plan and a warning is reported once the certificate enters the rotation window. The certificate is only replaced when renewed PEM content (e.g. by an ACME client) is supplied to `certificate`.
+
 Example Usage
 ```hcl
 
@@ -31,6 +35,25 @@ EOF
 	}
 
 ```
+
+Rotate certificate from local PEM files
+
+```hcl
+resource "zenlayercloud_zga_certificate" "rotated" {
+  certificate             = file("${path.module}/fullchain.pem")
+  key                     = file("${path.module}/privkey.pem")
+  auto_rotate_before_days = 30
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+resource "zenlayercloud_zga_certificate_binding" "default" {
+  accelerator_id = "<acceleratorId>"
+  certificate_id = zenlayercloud_zga_certificate.rotated.id
+}
+```
 Import
 
 Certificate can be imported using the id, e.g.
@@ -43,6 +66,12 @@ package zenlayercloud
 
 import (
 	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
 	"fmt"
 	"github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/common"
 	"strings"
@@ -50,8 +79,10 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/connectivity"
 	zga "github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/zga20230706"
 )
@@ -68,6 +99,10 @@ func resourceZenlayerCloudCertificate() *schema.Resource {
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(common.ZgaCreateTimeout),
 		},
+		CustomizeDiff: customdiff.All(
+			certificateValidFunc,
+			certificateRotationDiffFunc,
+		),
 		Schema: map[string]*schema.Schema{
 			"certificate": {
 				Type:             schema.TypeString,
@@ -113,6 +148,43 @@ func resourceZenlayerCloudCertificate() *schema.Resource {
 				Computed:    true,
 				Description: "Expiration time of the certificate.",
 			},
+			"auto_rotate_before_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Number of days before `not_after` from which the certificate is considered ready for rotation. `0` means disabled. Default is `0`.",
+			},
+			"common_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Common name of the leaf certificate parsed from `certificate`.",
+			},
+			"sans": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Subject alternative names of the leaf certificate parsed from `certificate`.",
+			},
+			"not_before": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Start time of the leaf certificate parsed from `certificate`, in RFC3339 format.",
+			},
+			"not_after": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expiration time of the leaf certificate parsed from `certificate`, in RFC3339 format.",
+			},
+			"fingerprint_sha256": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-256 fingerprint of the leaf certificate parsed from `certificate`, in lowercase hex.",
+			},
+			"ready_for_rotation": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the certificate is within `auto_rotate_before_days` of its expiration. It does not replace the certificate, change `certificate` and `key` to rotate it.",
+			},
 		},
 	}
 }
@@ -212,9 +284,160 @@ func resourceZenlayerCloudCertificateRead(ctx context.Context, d *schema.Resourc
 	_ = d.Set("resource_group_id", certInfo.ResourceGroupId)
 	_ = d.Set("label", certInfo.CertificateLabel)
 
+	if chain, err := parseCertificateChain(d.Get("certificate").(string)); err == nil {
+		for k, v := range flattenX509Certificate(chain[0]) {
+			_ = d.Set(k, v)
+		}
+	} else {
+		// The certificate content is not available after import.
+		_ = d.Set("common_name", certInfo.Common)
+		_ = d.Set("sans", certInfo.Sans)
+	}
+
+	if notAfter, ok := certificateNotAfter(d.Get("certificate").(string)); ok &&
+		isCertificateReadyForRotation(notAfter, d.Get("auto_rotate_before_days").(int), time.Now()) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Certificate is ready for rotation",
+			Detail:   fmt.Sprintf("The certificate %s expires at %s, please supply the renewed certificate and key.", certificateId, notAfter.Format(time.RFC3339)),
+		})
+	}
+
 	return diags
 }
 
+// certificateValidFunc checks the certificate and key before they are uploaded, and exposes
+// the parsed attributes in the plan.
+func certificateValidFunc(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() != "" && !diff.HasChange("certificate") && !diff.HasChange("key") {
+		return nil
+	}
+	if !diff.NewValueKnown("certificate") || !diff.NewValueKnown("key") {
+		return nil
+	}
+	certificate := diff.Get("certificate").(string)
+	key := diff.Get("key").(string)
+	if strings.TrimSpace(certificate) == "" || strings.TrimSpace(key) == "" {
+		return nil
+	}
+
+	chain, err := validateCertificate(certificate, key, time.Now())
+	if err != nil {
+		return err
+	}
+	for k, v := range flattenX509Certificate(chain[0]) {
+		if err := diff.SetNew(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateCertificate(certificate string, key string, now time.Time) ([]*x509.Certificate, error) {
+	chain, err := parseCertificateChain(certificate)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tls.X509KeyPair([]byte(strings.TrimSpace(certificate)), []byte(strings.TrimSpace(key))); err != nil {
+		return nil, fmt.Errorf("certificate and key do not match: %v", err)
+	}
+	for i := 0; i < len(chain)-1; i++ {
+		if err := chain[i].CheckSignatureFrom(chain[i+1]); err != nil {
+			return nil, fmt.Errorf("certificate chain is not in order: certificate %d (%s) is not issued by certificate %d (%s)",
+				i, chain[i].Subject.CommonName, i+1, chain[i+1].Subject.CommonName)
+		}
+	}
+	if !now.Before(chain[0].NotAfter) {
+		return nil, fmt.Errorf("certificate %s expired at %s", chain[0].Subject.CommonName, chain[0].NotAfter.Format(time.RFC3339))
+	}
+	return chain, nil
+}
+
+// parseCertificateChain parses all certificates in PEM content, the leaf certificate comes first.
+func parseCertificateChain(content string) ([]*x509.Certificate, error) {
+	var chain []*x509.Certificate
+	rest := []byte(strings.TrimSpace(content))
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse certificate %d: %v", len(chain), err)
+		}
+		chain = append(chain, cert)
+	}
+	if len(chain) == 0 {
+		return nil, errors.New("no PEM encoded certificate found")
+	}
+	return chain, nil
+}
+
+func flattenX509Certificate(cert *x509.Certificate) map[string]interface{} {
+	fingerprint := sha256.Sum256(cert.Raw)
+	return map[string]interface{}{
+		"common_name":        cert.Subject.CommonName,
+		"sans":               cert.DNSNames,
+		"not_before":         cert.NotBefore.Format(time.RFC3339),
+		"not_after":          cert.NotAfter.Format(time.RFC3339),
+		"fingerprint_sha256": hex.EncodeToString(fingerprint[:]),
+	}
+}
+
+// certificateCoversDomain reports whether the domain matches one of the subject alternative names,
+// a wildcard name only matches a single label.
+func certificateCoversDomain(sans []string, domain string) bool {
+	domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+	for _, san := range sans {
+		san = strings.ToLower(strings.TrimSuffix(san, "."))
+		if san == domain {
+			return true
+		}
+		if strings.HasPrefix(san, "*.") {
+			if i := strings.Index(domain, "."); i > 0 && domain[i+1:] == san[2:] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// certificateRotationDiffFunc surfaces `ready_for_rotation` in the plan once the certificate enters
+// the rotation window defined by `auto_rotate_before_days`. The certificate is only replaced when its
+// content changes, the same content uploaded again would just churn the certificate ID.
+func certificateRotationDiffFunc(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	notAfter, ok := certificateNotAfter(diff.Get("certificate").(string))
+	if !ok {
+		return nil
+	}
+	ready := isCertificateReadyForRotation(notAfter, diff.Get("auto_rotate_before_days").(int), time.Now())
+	if diff.Id() != "" && diff.Get("ready_for_rotation").(bool) == ready {
+		return nil
+	}
+	return diff.SetNew("ready_for_rotation", ready)
+}
+
+func isCertificateReadyForRotation(notAfter time.Time, beforeDays int, now time.Time) bool {
+	if beforeDays <= 0 {
+		return false
+	}
+	return now.Add(time.Duration(beforeDays) * 24 * time.Hour).After(notAfter)
+}
+
+// certificateNotAfter returns the expiration time of the leaf certificate in PEM content.
+func certificateNotAfter(content string) (time.Time, bool) {
+	chain, err := parseCertificateChain(content)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return chain[0].NotAfter, true
+}
+
 func StateTrimSpace(v interface{}) string {
 	s, ok := v.(string)
 
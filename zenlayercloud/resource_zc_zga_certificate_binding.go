@@ -0,0 +1,194 @@
+/*
+Provides a resource to bind a certificate to an accelerator.
+
+~> **NOTE:** The certificate of an accelerator can be managed either by `certificate_id` of `zenlayercloud_zga_accelerator` or by this resource, but not both. When using this resource, add `certificate` to `managed_externally` of the accelerator. Changing `certificate_id` swaps the certificate of the accelerator in place, so together with `create_before_destroy` on `zenlayercloud_zga_certificate` a certificate can be rotated without downtime. Destroying this resource only removes it from the state, the certificate stays bound to the accelerator.
+
+Example Usage
+
+```hcl
+
+resource "zenlayercloud_zga_certificate" "default" {
+  certificate = file("${path.module}/fullchain.pem")
+  key         = file("${path.module}/privkey.pem")
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+resource "zenlayercloud_zga_certificate_binding" "default" {
+  accelerator_id = "<acceleratorId>"
+  certificate_id = zenlayercloud_zga_certificate.default.id
+}
+
+```
+
+Import
+
+Accelerator certificate binding can be imported using the accelerator id, e.g.
+
+```
+$ terraform import zenlayercloud_zga_certificate_binding.default acceleratorId
+```
+*/
+package zenlayercloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	common2 "github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/common"
+	"github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/connectivity"
+	"github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/common"
+	zga "github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/zga20230706"
+)
+
+func resourceZenlayerCloudZgaCertificateBinding() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceZenlayerCloudZgaCertificateBindingCreate,
+		ReadContext:   resourceZenlayerCloudZgaCertificateBindingRead,
+		UpdateContext: resourceZenlayerCloudZgaCertificateBindingUpdate,
+		DeleteContext: resourceZenlayerCloudZgaCertificateBindingDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) ([]*schema.ResourceData, error) {
+				_ = d.Set("accelerator_id", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(common2.ZgaUpdateTimeout),
+			Update: schema.DefaultTimeout(common2.ZgaUpdateTimeout),
+		},
+		CustomizeDiff: zgaCertificateBindingDomainValidFunc,
+		Schema: map[string]*schema.Schema{
+			"accelerator_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the accelerator.",
+			},
+			"certificate_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the certificate to be bound to the accelerator.",
+			},
+		},
+	}
+}
+
+func resourceZenlayerCloudZgaCertificateBindingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer common2.LogElapsed(ctx, "resource.zenlayercloud_zga_certificate_binding.create")()
+
+	acceleratorId := d.Get("accelerator_id").(string)
+	err := modifyZgaAcceleratorCertificate(ctx, NewZgaService(meta.(*connectivity.ZenlayerCloudClient)), d, acceleratorId, d.Get("certificate_id").(string), schema.TimeoutCreate)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(acceleratorId)
+
+	return resourceZenlayerCloudZgaCertificateBindingRead(ctx, d, meta)
+}
+
+func resourceZenlayerCloudZgaCertificateBindingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var (
+		diags           diag.Diagnostics
+		acceleratorId   = d.Id()
+		acceleratorInfo *zga.AcceleratorInfo
+	)
+
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutRead)-time.Minute, func() *resource.RetryError {
+		var errRet error
+		acceleratorInfo, errRet = NewZgaService(meta.(*connectivity.ZenlayerCloudClient)).DescribeAcceleratorById(ctx, acceleratorId)
+		if errRet != nil {
+			return common2.RetryError(ctx, errRet, common2.InternalServerError)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if acceleratorInfo == nil || acceleratorInfo.Certificate == nil || acceleratorInfo.Certificate.CertificateId == "" {
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("accelerator_id", acceleratorId)
+	_ = d.Set("certificate_id", acceleratorInfo.Certificate.CertificateId)
+	return diags
+}
+
+func resourceZenlayerCloudZgaCertificateBindingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer common2.LogElapsed(ctx, "resource.zenlayercloud_zga_certificate_binding.update")()
+
+	if d.HasChange("certificate_id") {
+		err := modifyZgaAcceleratorCertificate(ctx, NewZgaService(meta.(*connectivity.ZenlayerCloudClient)), d, d.Id(), d.Get("certificate_id").(string), schema.TimeoutUpdate)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceZenlayerCloudZgaCertificateBindingRead(ctx, d, meta)
+}
+
+func resourceZenlayerCloudZgaCertificateBindingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// An https accelerator can not run without certificate, so the binding is only removed from the state.
+	return nil
+}
+
+func modifyZgaAcceleratorCertificate(ctx context.Context, zgaService *ZgaService, d *schema.ResourceData, acceleratorId string, certificateId string, timeoutKey string) error {
+	zgaAcceleratorMutexKV.Lock(acceleratorId)
+	defer zgaAcceleratorMutexKV.Unlock(acceleratorId)
+
+	err := resource.RetryContext(ctx, d.Timeout(timeoutKey)-time.Minute, func() *resource.RetryError {
+		errRet := zgaService.ModifyAcceleratorCertificateId(ctx, acceleratorId, certificateId)
+		if errRet != nil {
+			return common2.RetryError(ctx, errRet, common2.InternalServerError, common.NetworkError)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return waitAcceleratorDeploySuccess(ctx, zgaService, d, acceleratorId)
+}
+
+func zgaCertificateBindingDomainValidFunc(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if meta == nil || !diff.HasChange("certificate_id") || !diff.NewValueKnown("certificate_id") || !diff.NewValueKnown("accelerator_id") {
+		return nil
+	}
+	zgaService := NewZgaService(meta.(*connectivity.ZenlayerCloudClient))
+	acceleratorInfo, err := zgaService.DescribeAcceleratorById(ctx, diff.Get("accelerator_id").(string))
+	if err != nil || acceleratorInfo == nil || acceleratorInfo.Domain == nil || acceleratorInfo.Domain.Domain == "" {
+		// Errors are reported by apply.
+		return nil
+	}
+	domains := append([]string{acceleratorInfo.Domain.Domain}, splitStringByCommaOrSemicolon(acceleratorInfo.Domain.RelateDomains)...)
+	return checkZgaCertificateCoversDomains(ctx, zgaService, diff.Get("certificate_id").(string), domains)
+}
+
+// checkZgaCertificateCoversDomains verifies the subject alternative names of an uploaded certificate
+// cover all domains of the accelerator.
+func checkZgaCertificateCoversDomains(ctx context.Context, zgaService *ZgaService, certificateId string, domains []string) error {
+	certInfo, err := zgaService.DescribeCertificateById(ctx, certificateId)
+	if err != nil || certInfo == nil {
+		// Errors are reported by apply.
+		return nil
+	}
+	sans := certInfo.Sans
+	if len(sans) == 0 && certInfo.Common != "" {
+		sans = []string{certInfo.Common}
+	}
+	for _, domain := range domains {
+		if !certificateCoversDomain(sans, domain) {
+			return fmt.Errorf("certificate %s does not cover domain %s, sans of the certificate: %v", certificateId, domain, sans)
+		}
+	}
+	return nil
+}
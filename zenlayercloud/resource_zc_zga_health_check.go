@@ -0,0 +1,210 @@
+/*
+Provides a resource to manage the health check of accelerator.
+
+~> **NOTE:** Health check of an accelerator can be managed either inline by `health_check` of `zenlayercloud_zga_accelerator` or by this resource, but not both. Destroying this resource disables the health check of the accelerator.
+
+Example Usage
+
+```hcl
+
+resource "zenlayercloud_zga_listener" "tcp" {
+  accelerator_id = "<acceleratorId>"
+  protocol       = "tcp"
+  port           = 80
+  back_port      = 80
+}
+
+resource "zenlayercloud_zga_health_check" "default" {
+  accelerator_id = "<acceleratorId>"
+  enable         = true
+  alarm          = true
+  port           = 80
+
+  depends_on = [zenlayercloud_zga_listener.tcp]
+}
+
+```
+
+Import
+
+Accelerator health check can be imported using the accelerator id, e.g.
+
+```
+$ terraform import zenlayercloud_zga_health_check.default acceleratorId
+```
+*/
+package zenlayercloud
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	common2 "github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/common"
+	"github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/connectivity"
+	"github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/common"
+	zga "github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/zga20230706"
+)
+
+func resourceZenlayerCloudZgaHealthCheck() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceZenlayerCloudZgaHealthCheckCreate,
+		ReadContext:   resourceZenlayerCloudZgaHealthCheckRead,
+		UpdateContext: resourceZenlayerCloudZgaHealthCheckUpdate,
+		DeleteContext: resourceZenlayerCloudZgaHealthCheckDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) ([]*schema.ResourceData, error) {
+				_ = d.Set("accelerator_id", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(common2.ZgaUpdateTimeout),
+			Update: schema.DefaultTimeout(common2.ZgaUpdateTimeout),
+			Delete: schema.DefaultTimeout(common2.ZgaUpdateTimeout),
+		},
+		CustomizeDiff: func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+			if !diff.Get("enable").(bool) && (diff.Get("alarm").(bool) || diff.Get("port").(int) != 0) {
+				return errors.New("alarm and port cannot be configured when health check is disabled")
+			}
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"accelerator_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the accelerator.",
+			},
+			"enable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether to enable health check. Default is `true`.",
+			},
+			"alarm": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to enable alarm. Default is `false`.",
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IsPortNumberOrZero,
+				Description:  "The port of health check. Default is `0` (uses the backend service port). The port should be a single-port tcp or http/https listener of the accelerator.",
+			},
+		},
+	}
+}
+
+func resourceZenlayerCloudZgaHealthCheckCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer common2.LogElapsed(ctx, "resource.zenlayercloud_zga_health_check.create")()
+
+	acceleratorId := d.Get("accelerator_id").(string)
+	healthCheck := zga.HealthCheck{
+		Enable: d.Get("enable").(bool),
+		Alarm:  d.Get("alarm").(bool),
+		Port:   d.Get("port").(int),
+	}
+
+	err := modifyZgaAcceleratorHealthCheck(ctx, NewZgaService(meta.(*connectivity.ZenlayerCloudClient)), d, acceleratorId, healthCheck, schema.TimeoutCreate)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(acceleratorId)
+
+	return resourceZenlayerCloudZgaHealthCheckRead(ctx, d, meta)
+}
+
+func resourceZenlayerCloudZgaHealthCheckRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var (
+		diags           diag.Diagnostics
+		acceleratorId   = d.Id()
+		acceleratorInfo *zga.AcceleratorInfo
+	)
+
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutRead)-time.Minute, func() *resource.RetryError {
+		var errRet error
+		acceleratorInfo, errRet = NewZgaService(meta.(*connectivity.ZenlayerCloudClient)).DescribeAcceleratorById(ctx, acceleratorId)
+		if errRet != nil {
+			return common2.RetryError(ctx, errRet, common2.InternalServerError)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if acceleratorInfo == nil {
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("accelerator_id", acceleratorId)
+	if acceleratorInfo.HealthCheck != nil {
+		_ = d.Set("enable", acceleratorInfo.HealthCheck.Enable)
+		_ = d.Set("alarm", acceleratorInfo.HealthCheck.Alarm)
+		_ = d.Set("port", acceleratorInfo.HealthCheck.Port)
+	} else {
+		_ = d.Set("enable", false)
+		_ = d.Set("alarm", false)
+		_ = d.Set("port", 0)
+	}
+	return diags
+}
+
+func resourceZenlayerCloudZgaHealthCheckUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer common2.LogElapsed(ctx, "resource.zenlayercloud_zga_health_check.update")()
+
+	if d.HasChanges("enable", "alarm", "port") {
+		healthCheck := zga.HealthCheck{
+			Enable: d.Get("enable").(bool),
+			Alarm:  d.Get("alarm").(bool),
+			Port:   d.Get("port").(int),
+		}
+		err := modifyZgaAcceleratorHealthCheck(ctx, NewZgaService(meta.(*connectivity.ZenlayerCloudClient)), d, d.Id(), healthCheck, schema.TimeoutUpdate)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceZenlayerCloudZgaHealthCheckRead(ctx, d, meta)
+}
+
+func resourceZenlayerCloudZgaHealthCheckDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer common2.LogElapsed(ctx, "resource.zenlayercloud_zga_health_check.delete")()
+
+	err := modifyZgaAcceleratorHealthCheck(ctx, NewZgaService(meta.(*connectivity.ZenlayerCloudClient)), d, d.Id(), zga.HealthCheck{Enable: false}, schema.TimeoutDelete)
+	if err != nil {
+		if common2.IsExpectError(err, []string{"INVALID_ACCELERATOR_NOT_FOUND"}) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func modifyZgaAcceleratorHealthCheck(ctx context.Context, zgaService *ZgaService, d *schema.ResourceData, acceleratorId string, healthCheck zga.HealthCheck, timeoutKey string) error {
+	zgaAcceleratorMutexKV.Lock(acceleratorId)
+	defer zgaAcceleratorMutexKV.Unlock(acceleratorId)
+
+	err := resource.RetryContext(ctx, d.Timeout(timeoutKey)-time.Minute, func() *resource.RetryError {
+		errRet := zgaService.ModifyAcceleratorHealthCheck(ctx, acceleratorId, healthCheck)
+		if errRet != nil {
+			return common2.RetryError(ctx, errRet, common2.InternalServerError, common.NetworkError)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return waitAcceleratorDeploySuccess(ctx, zgaService, d, acceleratorId)
+}
@@ -0,0 +1,458 @@
+/*
+Provides a resource to manage a single listener of accelerator.
+
+~> **NOTE:** Listeners of an accelerator can be managed either inline by `l4_listeners`/`l7_listeners` of `zenlayercloud_zga_accelerator` or by this resource, but not both, otherwise they will overwrite each other. When using this resource, add `listeners` to `managed_externally` of the accelerator.
+
+~> **NOTE:** A listener referenced by access control rules can not be deleted, the rules should be removed first.
+
+Example Usage
+
+```hcl
+
+resource "zenlayercloud_zga_accelerator" "default" {
+  accelerator_name = "accelerator_test"
+  domain           = "test.com"
+  origin_region_id = "DE"
+  origin           = ["10.10.10.10"]
+  certificate_id   = "<certificateId>"
+  accelerate_regions {
+    accelerate_region_id = "KR"
+  }
+  managed_externally = ["listeners"]
+}
+
+resource "zenlayercloud_zga_listener" "tcp" {
+  accelerator_id = zenlayercloud_zga_accelerator.default.id
+  protocol       = "tcp"
+  port           = 80
+  back_port      = 80
+}
+
+resource "zenlayercloud_zga_listener" "https" {
+  accelerator_id = zenlayercloud_zga_accelerator.default.id
+  protocol       = "https"
+  port           = 443
+  back_port      = 80
+  back_protocol  = "http"
+}
+
+resource "zenlayercloud_zga_listener" "udp" {
+  accelerator_id  = zenlayercloud_zga_accelerator.default.id
+  protocol        = "udp"
+  port_range      = "53/54"
+  back_port_range = "53/54"
+}
+
+```
+
+Import
+
+Accelerator listener can be imported using the id, the id format must be '{accelerator_id}:{protocol}:{port or port_range}', e.g.
+
+```
+$ terraform import zenlayercloud_zga_listener.tcp acceleratorId:tcp:80
+$ terraform import zenlayercloud_zga_listener.udp acceleratorId:udp:53/54
+```
+*/
+package zenlayercloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	common2 "github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/common"
+	"github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/connectivity"
+	"github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/common"
+	zga "github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/zga20230706"
+)
+
+func resourceZenlayerCloudZgaListener() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceZenlayerCloudZgaListenerCreate,
+		ReadContext:   resourceZenlayerCloudZgaListenerRead,
+		UpdateContext: resourceZenlayerCloudZgaListenerUpdate,
+		DeleteContext: resourceZenlayerCloudZgaListenerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(common2.ZgaUpdateTimeout),
+			Update: schema.DefaultTimeout(common2.ZgaUpdateTimeout),
+			Delete: schema.DefaultTimeout(common2.ZgaUpdateTimeout),
+		},
+		CustomizeDiff: zgaListenerValidFunc,
+		Schema: map[string]*schema.Schema{
+			"accelerator_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the accelerator that the listener belongs to.",
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{ZgaTCPL4Protocol, ZgaUDPL4Protocol, ZgaHTTPL7Protocol, ZgaHTTPSL7Protocol}, false),
+				Description:  "The protocol of the listener. Valid values: `tcp`, `udp`, `http`, `https`.",
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"port", "port_range"},
+				ValidateFunc: validation.IsPortNumber,
+				Description:  "The port of the listener. Only port or port_range can be configured, and duplicate ports are not allowed.",
+			},
+			"back_port": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"port_range", "back_port_range"},
+				ValidateFunc:  validation.IsPortNumber,
+				Description:   "The Return-to-origin port of the listener. Required when `port` is set.",
+			},
+			"port_range": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"port", "port_range"},
+				ValidateFunc: IsPortRange,
+				Description:  "The port range of the listener. Only port or port_range can be configured. Use a slash (/) to separate the starting and ending ports, like: 1/200. The max range: 300.",
+			},
+			"back_port_range": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"port", "back_port"},
+				ValidateFunc:  IsPortRange,
+				Description:   "The Return-to-origin port range of the listener. Use a slash (/) to separate the starting and ending ports, like: 1/200. Required when `port_range` is set.",
+			},
+			"back_protocol": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{ZgaHTTPL7Protocol, ZgaHTTPSL7Protocol}, false),
+				Description:  "The Return-to-origin protocol of the l7 listener. Valid values: `http`, `https`. The default is equal to protocol. Only available for `http` and `https` listener.",
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The Return-to-origin host of the l7 listener. Only available for `http` and `https` listener.",
+			},
+		},
+	}
+}
+
+func resourceZenlayerCloudZgaListenerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer common2.LogElapsed(ctx, "resource.zenlayercloud_zga_listener.create")()
+
+	var (
+		zgaService    = NewZgaService(meta.(*connectivity.ZenlayerCloudClient))
+		acceleratorId = d.Get("accelerator_id").(string)
+		protocol      = d.Get("protocol").(string)
+		listenerKey   = zgaListenerKey(protocol, d.Get("port").(int), d.Get("port_range").(string))
+	)
+
+	err := modifyZgaAcceleratorListeners(ctx, zgaService, d, acceleratorId, schema.TimeoutCreate,
+		func(l4Listeners []*zga.AccelerationRuleL4Listener, l7Listeners []*zga.AccelerationRuleL7Listener) ([]*zga.AccelerationRuleL4Listener, []*zga.AccelerationRuleL7Listener, error) {
+			l4Listener, l7Listener := sharpenZgaListener(d)
+			if l4Listener != nil {
+				if findZgaL4Listener(l4Listeners, listenerKey) != -1 {
+					return nil, nil, fmt.Errorf("listener %s already exists in accelerator %s", listenerKey, acceleratorId)
+				}
+				l4Listeners = append(l4Listeners, l4Listener)
+			} else {
+				if findZgaL7Listener(l7Listeners, listenerKey) != -1 {
+					return nil, nil, fmt.Errorf("listener %s already exists in accelerator %s", listenerKey, acceleratorId)
+				}
+				l7Listeners = append(l7Listeners, l7Listener)
+			}
+			return l4Listeners, l7Listeners, nil
+		})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", acceleratorId, listenerKey))
+
+	return resourceZenlayerCloudZgaListenerRead(ctx, d, meta)
+}
+
+func resourceZenlayerCloudZgaListenerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var (
+		diags           diag.Diagnostics
+		zgaService      = NewZgaService(meta.(*connectivity.ZenlayerCloudClient))
+		acceleratorInfo *zga.AcceleratorInfo
+	)
+
+	acceleratorId, protocol, portValue, err := parseZgaListenerId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutRead)-time.Minute, func() *resource.RetryError {
+		var errRet error
+		acceleratorInfo, errRet = zgaService.DescribeAcceleratorById(ctx, acceleratorId)
+		if errRet != nil {
+			return common2.RetryError(ctx, errRet, common2.InternalServerError)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if acceleratorInfo == nil {
+		d.SetId("")
+		return nil
+	}
+
+	listenerKey := fmt.Sprintf("%s:%s", protocol, portValue)
+	var listener map[string]interface{}
+	if isZgaL4Protocol(protocol) {
+		if index := findZgaL4Listener(acceleratorInfo.L4Listeners, listenerKey); index != -1 {
+			listener = flattenL4Listeners(acceleratorInfo.L4Listeners[index : index+1])[0]
+		}
+	} else {
+		if index := findZgaL7Listener(acceleratorInfo.L7Listeners, listenerKey); index != -1 {
+			listener = flattenL7Listeners(acceleratorInfo.L7Listeners[index : index+1])[0]
+		}
+	}
+
+	if listener == nil {
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("accelerator_id", acceleratorId)
+	for _, key := range []string{"protocol", "port", "back_port", "port_range", "back_port_range", "back_protocol", "host"} {
+		_ = d.Set(key, listener[key])
+	}
+	return diags
+}
+
+func resourceZenlayerCloudZgaListenerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer common2.LogElapsed(ctx, "resource.zenlayercloud_zga_listener.update")()
+
+	var (
+		zgaService    = NewZgaService(meta.(*connectivity.ZenlayerCloudClient))
+		acceleratorId = d.Get("accelerator_id").(string)
+		listenerKey   = zgaListenerKey(d.Get("protocol").(string), d.Get("port").(int), d.Get("port_range").(string))
+	)
+
+	if d.HasChanges("back_port", "back_port_range", "back_protocol", "host") {
+		err := modifyZgaAcceleratorListeners(ctx, zgaService, d, acceleratorId, schema.TimeoutUpdate,
+			func(l4Listeners []*zga.AccelerationRuleL4Listener, l7Listeners []*zga.AccelerationRuleL7Listener) ([]*zga.AccelerationRuleL4Listener, []*zga.AccelerationRuleL7Listener, error) {
+				l4Listener, l7Listener := sharpenZgaListener(d)
+				if l4Listener != nil {
+					index := findZgaL4Listener(l4Listeners, listenerKey)
+					if index == -1 {
+						return nil, nil, fmt.Errorf("listener %s not found in accelerator %s", listenerKey, acceleratorId)
+					}
+					l4Listeners[index] = l4Listener
+				} else {
+					index := findZgaL7Listener(l7Listeners, listenerKey)
+					if index == -1 {
+						return nil, nil, fmt.Errorf("listener %s not found in accelerator %s", listenerKey, acceleratorId)
+					}
+					l7Listeners[index] = l7Listener
+				}
+				return l4Listeners, l7Listeners, nil
+			})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceZenlayerCloudZgaListenerRead(ctx, d, meta)
+}
+
+func resourceZenlayerCloudZgaListenerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer common2.LogElapsed(ctx, "resource.zenlayercloud_zga_listener.delete")()
+
+	zgaService := NewZgaService(meta.(*connectivity.ZenlayerCloudClient))
+
+	acceleratorId, protocol, portValue, err := parseZgaListenerId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	listenerKey := fmt.Sprintf("%s:%s", protocol, portValue)
+
+	err = modifyZgaAcceleratorListeners(ctx, zgaService, d, acceleratorId, schema.TimeoutDelete,
+		func(l4Listeners []*zga.AccelerationRuleL4Listener, l7Listeners []*zga.AccelerationRuleL7Listener) ([]*zga.AccelerationRuleL4Listener, []*zga.AccelerationRuleL7Listener, error) {
+			if index := findZgaL4Listener(l4Listeners, listenerKey); index != -1 {
+				l4Listeners = append(l4Listeners[:index], l4Listeners[index+1:]...)
+			}
+			if index := findZgaL7Listener(l7Listeners, listenerKey); index != -1 {
+				l7Listeners = append(l7Listeners[:index], l7Listeners[index+1:]...)
+			}
+			return l4Listeners, l7Listeners, nil
+		})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// modifyZgaAcceleratorListeners applies modifyFunc to the current listeners of the accelerator and
+// writes the whole listener list back, as ModifyAcceleratorRule only supports full replacement.
+func modifyZgaAcceleratorListeners(ctx context.Context, zgaService *ZgaService, d *schema.ResourceData, acceleratorId string, timeoutKey string,
+	modifyFunc func([]*zga.AccelerationRuleL4Listener, []*zga.AccelerationRuleL7Listener) ([]*zga.AccelerationRuleL4Listener, []*zga.AccelerationRuleL7Listener, error)) error {
+
+	zgaAcceleratorMutexKV.Lock(acceleratorId)
+	defer zgaAcceleratorMutexKV.Unlock(acceleratorId)
+
+	var acceleratorInfo *zga.AcceleratorInfo
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutRead)-time.Minute, func() *resource.RetryError {
+		var errRet error
+		acceleratorInfo, errRet = zgaService.DescribeAcceleratorById(ctx, acceleratorId)
+		if errRet != nil {
+			return common2.RetryError(ctx, errRet, common2.InternalServerError)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if acceleratorInfo == nil {
+		if timeoutKey == schema.TimeoutDelete {
+			return nil
+		}
+		return fmt.Errorf("accelerator %s not found", acceleratorId)
+	}
+
+	// listeners referenced by access control rules, modifyFunc may reuse the underlying arrays
+	var referencedListeners []string
+	if acceleratorInfo.AccessControl != nil {
+		for _, rule := range acceleratorInfo.AccessControl.Rules {
+			if rule != nil && rule.Listener != ZgaAccessControlAllListener {
+				referencedListeners = append(referencedListeners, rule.Listener)
+			}
+		}
+	}
+
+	l4Listeners, l7Listeners, err := modifyFunc(acceleratorInfo.L4Listeners, acceleratorInfo.L7Listeners)
+	if err != nil {
+		return err
+	}
+
+	for _, listenerKey := range referencedListeners {
+		if findZgaL4Listener(l4Listeners, listenerKey) == -1 && findZgaL7Listener(l7Listeners, listenerKey) == -1 {
+			return fmt.Errorf("listener %s of accelerator %s is still referenced by access control rules, remove the rules first", listenerKey, acceleratorId)
+		}
+	}
+
+	err = resource.RetryContext(ctx, d.Timeout(timeoutKey)-time.Minute, func() *resource.RetryError {
+		errRet := zgaService.ModifyAcceleratorListener(ctx, acceleratorId, l4Listeners, l7Listeners)
+		if errRet != nil {
+			return common2.RetryError(ctx, errRet, common2.InternalServerError, common.NetworkError)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return waitAcceleratorDeploySuccess(ctx, zgaService, d, acceleratorId)
+}
+
+func sharpenZgaListener(d *schema.ResourceData) (*zga.AccelerationRuleL4Listener, *zga.AccelerationRuleL7Listener) {
+	protocol := d.Get("protocol").(string)
+	if isZgaL4Protocol(protocol) {
+		return &zga.AccelerationRuleL4Listener{
+			Protocol:      protocol,
+			Port:          d.Get("port").(int),
+			BackPort:      d.Get("back_port").(int),
+			PortRange:     d.Get("port_range").(string),
+			BackPortRange: d.Get("back_port_range").(string),
+		}, nil
+	}
+	backProtocol := d.Get("back_protocol").(string)
+	if backProtocol == "" {
+		backProtocol = protocol
+	}
+	return nil, &zga.AccelerationRuleL7Listener{
+		Protocol:      protocol,
+		Port:          d.Get("port").(int),
+		BackPort:      d.Get("back_port").(int),
+		PortRange:     d.Get("port_range").(string),
+		BackPortRange: d.Get("back_port_range").(string),
+		BackProtocol:  backProtocol,
+		Host:          d.Get("host").(string),
+	}
+}
+
+func zgaListenerValidFunc(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	protocol := diff.Get("protocol").(string)
+	if isZgaL4Protocol(protocol) {
+		if _, ok := diff.GetOk("back_protocol"); ok {
+			return errors.New("back_protocol cannot be configured with l4 listener")
+		}
+		if _, ok := diff.GetOk("host"); ok {
+			return errors.New("host cannot be configured with l4 listener")
+		}
+	}
+	_, _, err := ParsePort(map[string]interface{}{
+		"port":            diff.Get("port"),
+		"back_port":       diff.Get("back_port"),
+		"port_range":      diff.Get("port_range"),
+		"back_port_range": diff.Get("back_port_range"),
+	})
+	return err
+}
+
+func isZgaL4Protocol(protocol string) bool {
+	return protocol == ZgaTCPL4Protocol || protocol == ZgaUDPL4Protocol
+}
+
+// zgaListenerKey returns the identity of listener in the form of `$protocol:$port` or `$protocol:$portRange`,
+// which is the same as the listener referenced by access control rules.
+func zgaListenerKey(protocol string, port int, portRange string) string {
+	if port != 0 {
+		return fmt.Sprintf("%s:%d", protocol, port)
+	}
+	return fmt.Sprintf("%s:%s", protocol, portRange)
+}
+
+func findZgaL4Listener(listeners []*zga.AccelerationRuleL4Listener, listenerKey string) int {
+	for i, listener := range listeners {
+		if listener != nil && zgaListenerKey(listener.Protocol, listener.Port, listener.PortRange) == listenerKey {
+			return i
+		}
+	}
+	return -1
+}
+
+func findZgaL7Listener(listeners []*zga.AccelerationRuleL7Listener, listenerKey string) int {
+	for i, listener := range listeners {
+		if listener != nil && zgaListenerKey(listener.Protocol, listener.Port, listener.PortRange) == listenerKey {
+			return i
+		}
+	}
+	return -1
+}
+
+func parseZgaListenerId(id string) (acceleratorId, protocol, portValue string, err error) {
+	parts, err := common2.ParseResourceId(id, 3)
+	if err != nil {
+		return
+	}
+	acceleratorId, protocol, portValue = parts[0], parts[1], parts[2]
+	if strings.Contains(portValue, "/") {
+		_, errs := IsPortRange(portValue, "port_range")
+		if len(errs) > 0 {
+			err = errs[0]
+		}
+		return
+	}
+	if _, e := strconv.Atoi(portValue); e != nil {
+		err = fmt.Errorf("invalid listener port %s in id %s", portValue, id)
+	}
+	return
+}
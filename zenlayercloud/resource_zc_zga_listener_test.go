@@ -0,0 +1,92 @@
+package zenlayercloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestParseZgaListenerId(t *testing.T) {
+	cases := []struct {
+		id            string
+		acceleratorId string
+		protocol      string
+		portValue     string
+		expectErr     bool
+	}{
+		{id: "acc-1:tcp:80", acceleratorId: "acc-1", protocol: "tcp", portValue: "80"},
+		{id: "acc-1:udp:53/54", acceleratorId: "acc-1", protocol: "udp", portValue: "53/54"},
+		{id: "acc-1:tcp", expectErr: true},
+		{id: "acc-1:tcp:abc", expectErr: true},
+		{id: "acc-1:udp:54/53", expectErr: true},
+	}
+	for _, c := range cases {
+		acceleratorId, protocol, portValue, err := parseZgaListenerId(c.id)
+		if c.expectErr {
+			if err == nil {
+				t.Errorf("expected error for id %s", c.id)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unexpected error for id %s: %v", c.id, err)
+			continue
+		}
+		if acceleratorId != c.acceleratorId || protocol != c.protocol || portValue != c.portValue {
+			t.Errorf("id %s parsed as (%s, %s, %s)", c.id, acceleratorId, protocol, portValue)
+		}
+	}
+}
+
+func TestParseZgaAccessControlRuleId(t *testing.T) {
+	cases := []struct {
+		id            string
+		acceleratorId string
+		listener      string
+		directory     string
+		expectErr     bool
+	}{
+		{id: "acc-1:all:/", acceleratorId: "acc-1", listener: "all", directory: "/"},
+		{id: "acc-1:https:443:/admin", acceleratorId: "acc-1", listener: "https:443", directory: "/admin"},
+		{id: "acc-1:udp:53/54:/", acceleratorId: "acc-1", listener: "udp:53/54", directory: "/"},
+		{id: "acc-1:/", expectErr: true},
+		{id: "acc-1:ftp:21:/", expectErr: true},
+	}
+	for _, c := range cases {
+		acceleratorId, listener, directory, err := parseZgaAccessControlRuleId(c.id)
+		if c.expectErr {
+			if err == nil {
+				t.Errorf("expected error for id %s", c.id)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unexpected error for id %s: %v", c.id, err)
+			continue
+		}
+		if acceleratorId != c.acceleratorId || listener != c.listener || directory != c.directory {
+			t.Errorf("id %s parsed as (%s, %s, %s)", c.id, acceleratorId, listener, directory)
+		}
+	}
+}
+
+func TestZgaListenerKey(t *testing.T) {
+	if key := zgaListenerKey(ZgaTCPL4Protocol, 80, ""); key != "tcp:80" {
+		t.Errorf("unexpected key %s", key)
+	}
+	if key := zgaListenerKey(ZgaHTTPL7Protocol, 0, "8888/8890"); key != "http:8888/8890" {
+		t.Errorf("unexpected key %s", key)
+	}
+}
+
+func TestZgaManagedExternallySuppressFunc(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceZenlayerCloudAccelerator().Schema, map[string]interface{}{
+		"managed_externally": []interface{}{ZgaManagedExternallyListeners},
+	})
+	if !zgaManagedExternallySuppressFunc(ZgaManagedExternallyListeners)("l4_listeners.#", "1", "0", d) {
+		t.Errorf("expected listeners diff to be suppressed")
+	}
+	if zgaManagedExternallySuppressFunc(ZgaManagedExternallyAccessControlRules)("access_control.0.rules.#", "1", "0", d) {
+		t.Errorf("expected access control rules diff not to be suppressed")
+	}
+}
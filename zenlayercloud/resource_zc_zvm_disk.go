@@ -51,6 +51,7 @@ func resourceZenlayerCloudVmDisk() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: common2.GrowOnlyFieldValidFunc("disk_size", "last_resize_time"),
 		Schema: map[string]*schema.Schema{
 			"availability_zone": {
 				Type:        schema.TypeString,
@@ -73,9 +74,8 @@ func resourceZenlayerCloudVmDisk() *schema.Resource {
 			"disk_size": {
 				Type:         schema.TypeInt,
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: validation.IntAtLeast(20),
-				Description:  "The size of disk. Unit: GB. The minimum value is 20 GB.",
+				Description:  "The size of disk. Unit: GB. The minimum value is 20 GB. When resize the disk, the new size must be greater than the former value. The disk can be resized while attached, the filesystem in the guest needs to be extended after that.",
 			},
 			"resource_group_id": {
 				Type:        schema.TypeString,
@@ -112,6 +112,11 @@ func resourceZenlayerCloudVmDisk() *schema.Resource {
 				Computed:    true,
 				Description: "Expire time of the disk.",
 			},
+			"last_resize_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time when the last resize of the disk completed, in RFC 3339 format. Empty if the disk has not been resized by Terraform. Can be used to trigger extending the filesystem in the guest.",
+			},
 			"tags": {
 				Type:        schema.TypeMap,
 				Optional:    true,
@@ -234,6 +239,27 @@ func resourceZenlayerCloudVmDiskUpdate(ctx context.Context, d *schema.ResourceDa
 		}
 	}
 
+	if d.HasChange("disk_size") {
+		diskSize := d.Get("disk_size").(int)
+		err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate)-time.Minute, func() *resource.RetryError {
+			err := vmService.ResizeDisk(ctx, diskId, diskSize)
+			if err != nil {
+				return common2.RetryError(ctx, err, common2.InternalServerError, common.NetworkError)
+			}
+			return nil
+		})
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		err = vmService.WaitDiskResized(ctx, diskId, diskSize, d.Timeout(schema.TimeoutUpdate)-time.Minute)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error waiting for disk (%s) to be resized: %w", diskId, err))
+		}
+		_ = d.Set("last_resize_time", time.Now().UTC().Format(time.RFC3339))
+	}
+
 	// Handle tags change
 	if d.HasChange("tags") {
 		zrmService := zrm.NewZrmService(meta.(*connectivity.ZenlayerCloudClient))
@@ -72,6 +72,40 @@ func (s *BmcService) DescribeInstanceById(ctx context.Context, instanceId string
 	return
 }
 
+func (s *BmcService) DescribeInstanceTypes(ctx context.Context, instanceTypeIds []string) (instanceTypes []*bmc.InstanceType, err error) {
+	// at most 100 instance types can be queried in one request
+	for start := 0; start < len(instanceTypeIds); start += 100 {
+		end := start + 100
+		if end > len(instanceTypeIds) {
+			end = len(instanceTypeIds)
+		}
+		request := bmc.NewDescribeInstanceTypesRequest()
+		request.InstanceTypeIds = instanceTypeIds[start:end]
+
+		var response *bmc.DescribeInstanceTypesResponse
+		response, err = s.client.WithBmcClient().DescribeInstanceTypes(request)
+		common2.LogApiRequest(ctx, "DescribeInstanceTypes", request, response, err)
+		if err != nil {
+			return nil, err
+		}
+		instanceTypes = append(instanceTypes, response.Response.InstanceTypes...)
+	}
+	return
+}
+
+func (s *BmcService) DescribeInstanceTypeById(ctx context.Context, instanceTypeId string) (*bmc.InstanceType, error) {
+	instanceTypes, err := s.DescribeInstanceTypes(ctx, []string{instanceTypeId})
+	if err != nil {
+		return nil, err
+	}
+	for _, instanceType := range instanceTypes {
+		if instanceType.InstanceTypeId == instanceTypeId {
+			return instanceType, nil
+		}
+	}
+	return nil, nil
+}
+
 func (s *BmcService) DescribeInstanceMonitorHealth(ctx context.Context, instanceId string) (healthStatus *bmc.InstanceHealth, err error) {
 	request := bmc.NewDescribeInstancesMonitorHealthRequest()
 	request.InstanceIds = []string{instanceId}
@@ -90,6 +124,45 @@ func (s *BmcService) DescribeInstanceMonitorHealth(ctx context.Context, instance
 	return
 }
 
+func (s *BmcService) DescribeInstancesMonitorHealth(ctx context.Context, instanceIds []string) (healthList []*bmc.InstanceHealth, err error) {
+	// at most 100 instances can be queried in one request
+	for start := 0; start < len(instanceIds); start += 100 {
+		end := start + 100
+		if end > len(instanceIds) {
+			end = len(instanceIds)
+		}
+		request := bmc.NewDescribeInstancesMonitorHealthRequest()
+		request.InstanceIds = instanceIds[start:end]
+
+		var response *bmc.DescribeInstancesMonitorHealthResponse
+		response, err = s.client.WithBmcClient().DescribeInstancesMonitorHealth(request)
+		common2.LogApiRequest(ctx, "DescribeInstancesMonitorHealth", request, response, err)
+		if err != nil {
+			return nil, err
+		}
+		if response.Response != nil {
+			healthList = append(healthList, response.Response.MonitorHealthList...)
+		}
+	}
+	return
+}
+
+func (s *BmcService) InstanceHealthRefreshFunc(ctx context.Context, instanceId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		object, err := s.DescribeInstanceMonitorHealth(ctx, instanceId)
+		if err != nil {
+			return nil, "", err
+		}
+		if object == nil {
+			return nil, "", nil
+		}
+		if len(bmcInstanceUnhealthyItems(object)) > 0 {
+			return object, BmcInstanceHealthUnhealthy, nil
+		}
+		return object, BmcInstanceHealthHealthy, nil
+	}
+}
+
 func (s *BmcService) InstanceStateRefreshFunc(ctx context.Context, instanceId string, failStates []string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeInstanceById(ctx, instanceId)
@@ -120,6 +193,30 @@ func (s *BmcService) ModifyInstanceName(ctx context.Context, instanceId string,
 	return err
 }
 
+func (s *BmcService) StartInstance(ctx context.Context, instanceId string) error {
+	request := bmc.NewStartInstancesRequest()
+	request.InstanceIds = []string{instanceId}
+	response, err := s.client.WithBmcClient().StartInstances(request)
+	defer common2.LogApiRequest(ctx, "StartInstances", request, response, err)
+	return err
+}
+
+func (s *BmcService) StopInstance(ctx context.Context, instanceId string) error {
+	request := bmc.NewStopInstancesRequest()
+	request.InstanceIds = []string{instanceId}
+	response, err := s.client.WithBmcClient().StopInstances(request)
+	defer common2.LogApiRequest(ctx, "StopInstances", request, response, err)
+	return err
+}
+
+func (s *BmcService) RebootInstance(ctx context.Context, instanceId string) error {
+	request := bmc.NewRebootInstancesRequest()
+	request.InstanceIds = []string{instanceId}
+	response, err := s.client.WithBmcClient().RebootInstances(request)
+	defer common2.LogApiRequest(ctx, "RebootInstances", request, response, err)
+	return err
+}
+
 func (s *BmcService) DescribeInstancesByFilter(instanceFilter *InstancesFilter) (instances []*bmc.InstanceInfo, err error) {
 	request := convertRequestForInstanceFilter(instanceFilter)
 	var limit = 100
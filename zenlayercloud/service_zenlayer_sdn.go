@@ -20,15 +20,21 @@ import (
 	"github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/connectivity"
 	"github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/common"
 	sdn "github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/sdn20230830"
+	"io"
 	"log"
 	"math"
+	"net/http"
 	"sync"
+	"time"
 )
 
 type SdnService struct {
 	client *connectivity.ZenlayerCloudClient
 }
 
+// sdnCloudRouterMutexKV serializes the changes of edge points in the same cloud router.
+var sdnCloudRouterMutexKV = common2.NewMutexKV()
+
 func (s *SdnService) DeletePortById(ctx context.Context, portId string) (err error) {
 	// 判断已经终止 OPERATION_DENIED_INSTANCE_RECYCLED,
 	request := sdn.NewTerminatePortRequest()
@@ -94,6 +100,73 @@ func (s *SdnService) PortStateRefreshFunc(ctx context.Context, portId string, fa
 	}
 }
 
+// DescribePortPrivateConnects returns the private connects which are not recycled and still use the port.
+func (s *SdnService) DescribePortPrivateConnects(portId string) ([]*sdn.PrivateConnect, error) {
+	connects, err := s.DescribePrivateConnectsByFilter(&PrivateConnectFilter{
+		EndpointTypes: []string{POINT_TYPE_PORT},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return filterPortPrivateConnects(portId, connects), nil
+}
+
+func filterPortPrivateConnects(portId string, connects []*sdn.PrivateConnect) []*sdn.PrivateConnect {
+	var res []*sdn.PrivateConnect
+	for _, connect := range connects {
+		if connect.PrivateConnectStatus == SdnStatusRecycle || connect.PrivateConnectStatus == SdnStatusReleasing {
+			continue
+		}
+		for _, endpoint := range []sdn.PrivateConnectEndpoint{connect.EndpointA, connect.EndpointZ} {
+			if endpoint.EndpointType == POINT_TYPE_PORT && endpoint.EndpointId == portId {
+				res = append(res, connect)
+				break
+			}
+		}
+	}
+	return res
+}
+
+// DownloadPortLoa downloads the LOA document from the download URL returned by DescribePorts.
+// The URL is pre-signed, so the request carries no credential of the provider.
+func (s *SdnService) DownloadPortLoa(ctx context.Context, loaUrl string) ([]byte, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, loaUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+	httpClient := &http.Client{Timeout: time.Minute}
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return nil, common.NewZenlayerCloudSdkError(common.NetworkError, fmt.Sprintf("Fail to download LOA because %s", err), "")
+	}
+	defer response.Body.Close()
+
+	switch {
+	case response.StatusCode == http.StatusOK:
+		return io.ReadAll(response.Body)
+	case response.StatusCode == http.StatusRequestTimeout || response.StatusCode == http.StatusGatewayTimeout:
+		return nil, common.NewZenlayerCloudSdkError(common2.ReadTimedOut, fmt.Sprintf("failed to download LOA, status code: %d", response.StatusCode), "")
+	case response.StatusCode >= http.StatusInternalServerError:
+		return nil, common.NewZenlayerCloudSdkError(common2.InternalServerError, fmt.Sprintf("failed to download LOA, status code: %d", response.StatusCode), "")
+	default:
+		return nil, fmt.Errorf("failed to download LOA, status code: %d", response.StatusCode)
+	}
+}
+
+func (s *SdnService) DescribePortTraffic(ctx context.Context, portId, startTime, endTime string) (*sdn.DescribePortTrafficResponseParams, error) {
+	request := sdn.NewDescribePortTrafficRequest()
+	request.PortId = portId
+	request.StartTime = startTime
+	request.EndTime = endTime
+
+	response, err := s.client.WithSdnClient().DescribePortTraffic(request)
+	defer common2.LogApiRequest(ctx, "DescribePortTraffic", request, response, err)
+	if err != nil {
+		return nil, err
+	}
+	return response.Response, nil
+}
+
 func (s *SdnService) ModifyPort(ctx context.Context, portId, portName, remarks, businessEntityName string) error {
 	request := sdn.NewModifyPortAttributeRequest()
 	request.PortId = portId
@@ -191,7 +264,7 @@ func (s *SdnService) DescribePrivateConnectsByFilter(filter *PrivateConnectFilte
 			request.GetAction(), common2.ToJsonString(request), err.Error())
 		return
 	}
-	if response == nil || len(response.Response.DataSet) < 1 {
+	if response == nil || response.Response == nil || len(response.Response.DataSet) < 1 {
 		return
 	}
 
@@ -206,36 +279,51 @@ func (s *SdnService) DescribePrivateConnectsByFilter(filter *PrivateConnectFilte
 	wg := sync.WaitGroup{}
 
 	var portSetList = make([]interface{}, num)
+	var firstErr error
+	var mu sync.Mutex
 
 	for i := 0; i < num; i++ {
 		wg.Add(1)
 		value := i
 		goFunc := func() {
+			defer wg.Done()
 			request := convertRequestForPrivateConnectFilter(filter)
 
 			request.PageNum = value + 2
 			request.PageSize = limit
 
 			response, err := s.client.WithSdnClient().DescribePrivateConnects(request)
+			mu.Lock()
+			defer mu.Unlock()
 			if err != nil {
 				log.Printf("[CRITAL] Api[%s] fail, request body [%s], error[%s]\n",
 					request.GetAction(), common2.ToJsonString(request), err.Error())
+				if firstErr == nil {
+					firstErr = err
+				}
 				return
 			}
 			log.Printf("[DEBUG] Api[%s] success, request body [%s], response body [%s]\n",
 				request.GetAction(), common2.ToJsonString(request), common2.ToJsonString(response))
 
-			portSetList[value] = response.Response.DataSet
+			if response != nil && response.Response != nil {
+				portSetList[value] = response.Response.DataSet
+			}
 
-			wg.Done()
 			log.Printf("[DEBUG] thread %d finished", value)
 		}
 		g.Run(goFunc)
 	}
 	wg.Wait()
 
+	if firstErr != nil {
+		return nil, firstErr
+	}
 	log.Printf("[DEBUG] DescribePrivateConnects request finished")
 	for _, v := range portSetList {
+		if v == nil {
+			continue
+		}
 		privateConnects = append(privateConnects, v.([]*sdn.PrivateConnect)...)
 	}
 	log.Printf("[DEBUG] transfer private connects finished")
@@ -323,6 +411,70 @@ func (s *SdnService) ModifyPrivateConnectBandwidth(ctx context.Context, connectI
 	return err
 }
 
+func (s *SdnService) InquiryCreatePrivateConnectPrice(ctx context.Context, endpointA, endpointZ sdn.CreateEndpointParam, bandwidth int) (*sdn.Price, error) {
+	request := sdn.NewInquiryCreatePrivateConnectPriceRequest()
+	request.EndpointA = endpointA
+	request.EndpointZ = endpointZ
+	request.BandwidthMbps = bandwidth
+
+	response, err := s.client.WithSdnClient().InquiryCreatePrivateConnectPrice(request)
+	defer common2.LogApiRequest(ctx, "InquiryCreatePrivateConnectPrice", request, response, err)
+	if err != nil {
+		return nil, err
+	}
+	if response.Response == nil {
+		return nil, nil
+	}
+	return &response.Response.PrivateConnectPrice, nil
+}
+
+func (s *SdnService) QueryPrivateConnectBandwidthPrice(ctx context.Context, sourceDcId, destinationDcId string, bandwidth int) (*sdn.QueryPrivateConnectBandwidthPriceResponseParams, error) {
+	request := sdn.NewQueryPrivateConnectBandwidthPriceRequest()
+	request.SourceDcId = sourceDcId
+	request.DestinationDcId = destinationDcId
+	request.BandwidthMbps = bandwidth
+
+	response, err := s.client.WithSdnClient().QueryPrivateConnectBandwidthPrice(request)
+	defer common2.LogApiRequest(ctx, "QueryPrivateConnectBandwidthPrice", request, response, err)
+	if err != nil {
+		return nil, err
+	}
+	return response.Response, nil
+}
+
+func (s *SdnService) DescribeCloudAvailableBandwidthTiers(ctx context.Context, endpoint sdn.CreateEndpointParam) ([]int, error) {
+	request := sdn.NewDescribeCloudAvailableBandwidthTiersRequest()
+	request.DcId = endpoint.DcId
+	request.CloudType = endpoint.CloudType
+	request.CloudRegionId = endpoint.CloudRegionId
+	request.CloudAccountId = endpoint.CloudAccountId
+	request.VlanId = endpoint.VlanId
+
+	response, err := s.client.WithSdnClient().DescribeCloudAvailableBandwidthTiers(request)
+	defer common2.LogApiRequest(ctx, "DescribeCloudAvailableBandwidthTiers", request, response, err)
+	if err != nil {
+		return nil, err
+	}
+	if response.Response == nil {
+		return nil, nil
+	}
+	return response.Response.AvailableBandwidthTiers, nil
+}
+
+func (s *SdnService) DescribePrivateConnectTraffic(ctx context.Context, connectId, startTime, endTime string) (*sdn.DescribePrivateConnectTrafficResponseParams, error) {
+	request := sdn.NewDescribePrivateConnectTrafficRequest()
+	request.PrivateConnectId = connectId
+	request.StartTime = startTime
+	request.EndTime = endTime
+
+	response, err := s.client.WithSdnClient().DescribePrivateConnectTraffic(request)
+	defer common2.LogApiRequest(ctx, "DescribePrivateConnectTraffic", request, response, err)
+	if err != nil {
+		return nil, err
+	}
+	return response.Response, nil
+}
+
 func (s *SdnService) DescribePrivateConnectById(ctx context.Context, connectId string) (connect *sdn.PrivateConnect, err error) {
 	request := sdn.NewDescribePrivateConnectsRequest()
 	request.PrivateConnectIds = []string{connectId}
@@ -362,6 +514,26 @@ func (s *SdnService) PrivateConnectStateRefreshFunc(ctx context.Context, connect
 	}
 }
 
+// PrivateConnectConnectivityRefreshFunc reports any connectivity status other than ACTIVE as DOWN.
+func (s *SdnService) PrivateConnectConnectivityRefreshFunc(ctx context.Context, connectId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		object, err := s.DescribePrivateConnectById(ctx, connectId)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if object == nil {
+			// Set this to nil as if we didn't find anything.
+			return nil, "", nil
+		}
+
+		if object.ConnectivityStatus != SdnConnectivityActive {
+			return object, SdnConnectivityDown, nil
+		}
+		return object, object.ConnectivityStatus, nil
+	}
+}
+
 func (s *SdnService) DeletePrivateConnectById(ctx context.Context, connectId string) (err error) {
 	// 判断已经终止 INVALID_PRIVATE_CONNECT_NOT_FOUND,
 	request := sdn.NewDeletePrivateConnectRequest()
@@ -450,5 +622,114 @@ func convertRequestForPrivateConnectFilter(filter *PrivateConnectFilter) (reques
 	if len(filter.ConnectIds) > 0 {
 		request.PrivateConnectIds = filter.ConnectIds
 	}
+	if len(filter.EndpointTypes) > 0 {
+		request.EndpointTypes = filter.EndpointTypes
+	}
+	return
+}
+
+func (s *SdnService) DescribeCloudRouterById(ctx context.Context, cloudRouterId string) (cloudRouter *sdn.CloudRouter, err error) {
+	request := sdn.NewDescribeCloudRoutersRequest()
+	request.CloudRouterIds = []string{cloudRouterId}
+
+	response, err := s.client.WithSdnClient().DescribeCloudRouters(request)
+
+	defer common2.LogApiRequest(ctx, "DescribeCloudRouters", request, response, err)
+	if err != nil {
+		return
+	}
+
+	if len(response.Response.DataSet) < 1 {
+		return
+	}
+	cloudRouter = response.Response.DataSet[0]
+	return
+}
+
+func (s *SdnService) CloudRouterStateRefreshFunc(ctx context.Context, cloudRouterId string, failStates []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		object, err := s.DescribeCloudRouterById(ctx, cloudRouterId)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if object == nil {
+			// Set this to nil as if we didn't find anything.
+			return nil, "", nil
+		}
+		for _, failState := range failStates {
+			if object.CloudRouterStatus == failState {
+				return object, object.CloudRouterStatus, common2.Error("Failed to reach target status. Last status: %s.", object.CloudRouterStatus)
+			}
+		}
+
+		return object, object.CloudRouterStatus, nil
+	}
+}
+
+func (s *SdnService) ModifyCloudRouterAttribute(ctx context.Context, cloudRouterId string, name string, description string) error {
+	request := sdn.NewModifyCloudRoutersAttributeRequest()
+	request.CloudRouterIds = []string{cloudRouterId}
+	request.CloudRouterName = name
+	request.CloudRouterDescription = description
+	response, err := s.client.WithSdnClient().ModifyCloudRoutersAttribute(request)
+	common2.LogApiRequest(ctx, "ModifyCloudRoutersAttribute", request, response, err)
+	return err
+}
+
+func (s *SdnService) AddCloudRouterEdgePoint(ctx context.Context, cloudRouterId string, edgePoint *sdn.CreateCloudRouterEdgePoint) (edgePointId string, err error) {
+	request := sdn.NewAddCloudRouterEdgePointsRequest()
+	request.CloudRouterId = cloudRouterId
+	request.EdgePoints = []*sdn.CreateCloudRouterEdgePoint{edgePoint}
+	response, err := s.client.WithSdnClient().AddCloudRouterEdgePoints(request)
+	defer common2.LogApiRequest(ctx, "AddCloudRouterEdgePoints", request, response, err)
+	if err != nil {
+		return
+	}
+	if len(response.Response.EdgePointIds) < 1 {
+		err = fmt.Errorf("edge point id is nil")
+		return
+	}
+	edgePointId = response.Response.EdgePointIds[0]
+	return
+}
+
+func (s *SdnService) ModifyCloudRouterEdgePoint(ctx context.Context, request *sdn.ModifyCloudRouterEdgePointRequest) error {
+	response, err := s.client.WithSdnClient().ModifyCloudRouterEdgePoint(request)
+	common2.LogApiRequest(ctx, "ModifyCloudRouterEdgePoint", request, response, err)
+	return err
+}
+
+func (s *SdnService) DeleteCloudRouterEdgePoint(ctx context.Context, cloudRouterId string, edgePointId string) error {
+	request := sdn.NewDeleteCloudRouterEdgePointRequest()
+	request.CloudRouterId = cloudRouterId
+	request.EdgePointId = edgePointId
+	response, err := s.client.WithSdnClient().DeleteCloudRouterEdgePoint(request)
+	common2.LogApiRequest(ctx, "DeleteCloudRouterEdgePoint", request, response, err)
+	return err
+}
+
+func (s *SdnService) DeleteCloudRouterById(ctx context.Context, cloudRouterId string) (err error) {
+	request := sdn.NewDeleteCloudRouterRequest()
+	request.CloudRouterId = cloudRouterId
+	response, err := s.client.WithSdnClient().DeleteCloudRouter(request)
+	defer common2.LogApiRequest(ctx, "DeleteCloudRouter", request, response, err)
+
+	if err != nil {
+		if sdkError, ok := err.(*common.ZenlayerCloudSdkError); ok {
+			if sdkError.Code == "INVALID_CLOUD_ROUTER_NOT_FOUND" {
+				return nil
+			}
+		}
+		return
+	}
+	return
+}
+
+func (s *SdnService) DestroyCloudRouter(ctx context.Context, cloudRouterId string) (err error) {
+	request := sdn.NewDestroyCloudRouterRequest()
+	request.CloudRouterId = cloudRouterId
+	response, err := s.client.WithSdnClient().DestroyCloudRouter(request)
+	defer common2.LogApiRequest(ctx, "DestroyCloudRouter", request, response, err)
 	return
 }
@@ -14,6 +14,7 @@ import (
 	"log"
 	"math"
 	"sync"
+	"time"
 )
 
 type VmService struct {
@@ -567,6 +568,48 @@ func (s *VmService) DescribeDiskById(ctx context.Context, diskId string) (*vm.Di
 	return diskInfo, nil
 }
 
+func (s *VmService) ResizeDisk(ctx context.Context, diskId string, diskSize int) error {
+	request := vm.NewResizeDiskRequest()
+	request.DiskId = &diskId
+	request.DiskSize = &diskSize
+	response, err := s.client.WithVmClient().ResizeDisk(request)
+	defer common2.LogApiRequest(ctx, "ResizeDisk", request, response, err)
+	return err
+}
+
+// vmDiskStatusResizing is reported while the disk is not yet settled with the expected size,
+// it is not a status returned by the API.
+const vmDiskStatusResizing = "RESIZING"
+
+// WaitDiskResized waits until the disk is settled with the expected size after ResizeDisk.
+func (s *VmService) WaitDiskResized(ctx context.Context, diskId string, diskSize int, timeout time.Duration) error {
+	refresh := s.DiskStateRefreshFunc(ctx, diskId, []string{VmDiskStatusRecycle})
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{vmDiskStatusResizing},
+		Target: []string{
+			VmDiskStatusInUse,
+			VmDiskStatusAvailable,
+		},
+		Refresh: func() (interface{}, string, error) {
+			object, status, err := refresh()
+			if err != nil || object == nil {
+				return object, status, err
+			}
+			disk := object.(*vm.DiskInfo)
+			if disk.DiskSize < diskSize || (status != VmDiskStatusInUse && status != VmDiskStatusAvailable) {
+				return object, vmDiskStatusResizing, nil
+			}
+			return object, status, nil
+		},
+		Timeout:        timeout,
+		Delay:          3 * time.Second,
+		MinTimeout:     5 * time.Second,
+		NotFoundChecks: 3,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
 func (s *VmService) ModifyDiskName(ctx context.Context, diskId string, diskName string) error {
 	request := vm.NewModifyDisksAttributesRequest()
 	request.DiskIds = []string{diskId}
@@ -9,6 +9,10 @@ import (
 	zga "github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/zga20230706"
 )
 
+// zgaAcceleratorMutexKV serializes the read-modify-write of accelerator listeners,
+// access control rules and health check made by different resources.
+var zgaAcceleratorMutexKV = common.NewMutexKV()
+
 type ZgaService struct {
 	client *connectivity.ZenlayerCloudClient
 }
@@ -333,3 +337,21 @@ func (s *ZgaService) DeleteAcceleratorById(ctx context.Context, acceleratorId st
 	}
 	return nil
 }
+
+func (s *ZgaService) DescribeAcceleratorTraffic(ctx context.Context, request *zga.DescribeAcceleratorTrafficRequest) (*zga.DescribeAcceleratorTrafficResponseParams, error) {
+	response, err := s.client.WithZgaClient().DescribeAcceleratorTraffic(request)
+	common.LogApiRequest(ctx, request.GetAction(), request, response, err)
+	if err != nil {
+		return nil, err
+	}
+	return response.Response, nil
+}
+
+func (s *ZgaService) DescribeAcceleratorMetrics(ctx context.Context, request *zga.DescribeAcceleratorMetricsRequest) (*zga.DescribeAcceleratorMetricsResponseParams, error) {
+	response, err := s.client.WithZgaClient().DescribeAcceleratorMetrics(request)
+	common.LogApiRequest(ctx, request.GetAction(), request, response, err)
+	if err != nil {
+		return nil, err
+	}
+	return response.Response, nil
+}
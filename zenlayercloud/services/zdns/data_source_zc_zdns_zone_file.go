@@ -0,0 +1,65 @@
+package zdns
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/common"
+	"github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/connectivity"
+	common2 "github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/common"
+)
+
+func DataSourceZenlayerCloudPvtdnsZoneFile() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceZenlayerCloudPvtdnsZoneFileRead,
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the DNS private zone.",
+			},
+			"zone_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the DNS private zone.",
+			},
+			"content": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The records of the `default` line rendered in RFC 1035 zone file format.",
+			},
+			"record_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of records rendered.",
+			},
+		},
+	}
+}
+
+func dataSourceZenlayerCloudPvtdnsZoneFileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer common.LogElapsed(ctx, "data_source.zenlayercloud_zdns_zone_file.read")()
+
+	pvtDnsService := ZdnsService{
+		client: meta.(*connectivity.ZenlayerCloudClient),
+	}
+	zoneId := d.Get("zone_id").(string)
+
+	zone, records, err := describePvtdnsZoneFileRecords(ctx, &pvtDnsService, zoneId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if zone == nil {
+		return diag.FromErr(fmt.Errorf("private zone %s is not exist", zoneId))
+	}
+	zoneName := common2.ToString(zone.ZoneName)
+
+	d.SetId(zoneId)
+	_ = d.Set("zone_name", zoneName)
+	_ = d.Set("content", renderZoneFile(zoneName, records))
+	_ = d.Set("record_count", len(records))
+
+	return nil
+}
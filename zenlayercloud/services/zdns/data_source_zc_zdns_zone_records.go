@@ -187,4 +187,6 @@ type PrivateRecordFilter struct {
 	RecordIds   []string
 	RecordType  string
 	RecordValue string
+	RecordName  string
+	Line        string
 }
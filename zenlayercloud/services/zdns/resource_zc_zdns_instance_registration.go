@@ -0,0 +1,650 @@
+package zdns
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	common2 "github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/common"
+	"github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/connectivity"
+	"github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/services/zec"
+	"github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/common"
+	pvtdns "github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/zdns20251101"
+	zec2 "github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/zec20250901"
+)
+
+func ResourceZenlayerCloudPvtdnsInstanceRegistration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceZenlayerCloudPvtdnsInstanceRegistrationCreate,
+		ReadContext:   resourceZenlayerCloudPvtdnsInstanceRegistrationRead,
+		UpdateContext: resourceZenlayerCloudPvtdnsInstanceRegistrationUpdate,
+		DeleteContext: resourceZenlayerCloudPvtdnsInstanceRegistrationDelete,
+
+		CustomizeDiff: instanceRegistrationRecordsDiffFunc,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the private zone where the A/AAAA records are registered.",
+			},
+			"instance_ids": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				AtLeastOneOf: []string{"instance_ids", "instance_tags"},
+				Description:  "IDs of the ZEC instances to be registered.",
+			},
+			"instance_tags": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				AtLeastOneOf: []string{"instance_ids", "instance_tags"},
+				Description:  "Tags of the ZEC instances to be registered. If `instance_ids` is also specified, only the instances matching both are registered.",
+			},
+			"name_template": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "{instance_name}",
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "Template of the record name. Supported placeholders: `{instance_name}`, `{instance_id}` and `{zone}`. Names ending with the zone name are converted to be relative to the zone. Default is `{instance_name}`.",
+			},
+			"enable_ipv6": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to register `AAAA` records for the primary IPv6 addresses of the instances. Default is `false`.",
+			},
+			"ptr_zone_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The ID of the reverse private zone, such as `168.192.in-addr.arpa`, where the `PTR` records are registered. The addresses out of the reverse zone are skipped.",
+			},
+			"ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(5, 86400),
+				Description:  "The ttl of the records. Measured in second. Range: [5,86400].",
+			},
+			"records": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "The records registered for the instances.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the instance.",
+						},
+						"zone_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the private zone of the record.",
+						},
+						"record_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the record.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the record.",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The value of the record.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type instanceRegistrationRecord struct {
+	InstanceId string
+	ZoneId     string
+	RecordName string
+	Type       string
+	Value      string
+}
+
+func (r instanceRegistrationRecord) key() string {
+	return r.ZoneId + "|" + r.RecordName + "|" + r.Type + "|" + r.Value
+}
+
+// ownedBy reports whether the zone record is registered by this resource, which stamps the instance ID into the remark.
+func (r instanceRegistrationRecord) ownedBy(record *pvtdns.PrivateZoneRecord) bool {
+	return common.ToString(record.Remark) == r.InstanceId
+}
+
+func (r instanceRegistrationRecord) toMap() map[string]interface{} {
+	return map[string]interface{}{
+		"instance_id": r.InstanceId,
+		"zone_id":     r.ZoneId,
+		"record_name": r.RecordName,
+		"type":        r.Type,
+		"value":       r.Value,
+	}
+}
+
+type zecInstance struct {
+	id     string
+	name   string
+	status string
+	ipv4   string
+	ipv6   string
+}
+
+func newZecInstance(instance *zec2.InstanceInfo) *zecInstance {
+	i := &zecInstance{
+		id:     common.ToString(instance.InstanceId),
+		name:   common.ToString(instance.InstanceName),
+		status: common.ToString(instance.Status),
+	}
+	for _, ip := range instance.PrivateIpAddresses {
+		if parsed := net.ParseIP(ip); parsed != nil && parsed.To4() != nil {
+			i.ipv4 = ip
+			break
+		}
+	}
+	for _, nic := range instance.Nics {
+		if nic != nil && common.ToString(nic.PrimaryIpv6) != "" {
+			i.ipv6 = *nic.PrimaryIpv6
+			break
+		}
+	}
+	return i
+}
+
+// registrable reports whether the instance is neither failed nor being released.
+func (i *zecInstance) registrable() bool {
+	switch i.status {
+	case zec.ZecInstanceStatusCreateFailed, zec.ZecInstanceStatusReleasing, zec.ZecInstanceStatusRecycle, zec.ZecInstanceStatusRecycling:
+		return false
+	}
+	return true
+}
+
+// instanceRegistrationGetter is implemented by both schema.ResourceData and schema.ResourceDiff.
+type instanceRegistrationGetter interface {
+	Get(key string) interface{}
+}
+
+var invalidRecordNameChars = regexp.MustCompile(`[^a-z0-9\-.]+`)
+
+// instanceRegistrationRecordsDiffFunc plans the records against the current instances, so an update is planned
+// once instances appear, change IP or disappear.
+func instanceRegistrationRecordsDiffFunc(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if meta == nil {
+		return nil
+	}
+	for _, key := range []string{"zone_id", "ptr_zone_id", "instance_ids", "instance_tags", "name_template", "enable_ipv6"} {
+		if !diff.NewValueKnown(key) {
+			return diff.SetNewComputed("records")
+		}
+	}
+
+	pvtDnsService := ZdnsService{
+		client: meta.(*connectivity.ZenlayerCloudClient),
+	}
+	desired, err := describeInstanceRegistrationRecords(ctx, &pvtDnsService, diff)
+	if err != nil {
+		return err
+	}
+
+	current := flattenInstanceRegistrationRecords(diff.Get("records").(*schema.Set))
+	if diff.Id() != "" && sameInstanceRegistrationRecords(current, desired) {
+		return nil
+	}
+	records := make([]interface{}, 0, len(desired))
+	for _, r := range desired {
+		records = append(records, r.toMap())
+	}
+	return diff.SetNew("records", records)
+}
+
+func resourceZenlayerCloudPvtdnsInstanceRegistrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer common2.LogElapsed(ctx, "resource.zenlayercloud_zdns_instance_registration.create")()
+
+	pvtDnsService := ZdnsService{
+		client: meta.(*connectivity.ZenlayerCloudClient),
+	}
+
+	records, err := applyInstanceRegistration(ctx, &pvtDnsService, d, nil, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(resource.PrefixedUniqueId(d.Get("zone_id").(string) + "-"))
+	_ = d.Set("records", records)
+
+	return resourceZenlayerCloudPvtdnsInstanceRegistrationRead(ctx, d, meta)
+}
+
+func resourceZenlayerCloudPvtdnsInstanceRegistrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer common2.LogElapsed(ctx, "resource.zenlayercloud_zdns_instance_registration.update")()
+
+	pvtDnsService := ZdnsService{
+		client: meta.(*connectivity.ZenlayerCloudClient),
+	}
+
+	old, _ := d.GetChange("records")
+	records, err := applyInstanceRegistration(ctx, &pvtDnsService, d, flattenInstanceRegistrationRecords(old.(*schema.Set)), d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_ = d.Set("records", records)
+
+	return resourceZenlayerCloudPvtdnsInstanceRegistrationRead(ctx, d, meta)
+}
+
+func resourceZenlayerCloudPvtdnsInstanceRegistrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer common2.LogElapsed(ctx, "resource.zenlayercloud_zdns_instance_registration.read")()
+
+	var diags diag.Diagnostics
+
+	pvtDnsService := ZdnsService{
+		client: meta.(*connectivity.ZenlayerCloudClient),
+	}
+	zoneId := d.Get("zone_id").(string)
+
+	var zone *pvtdns.PrivateZone
+	err := resource.RetryContext(ctx, common2.ReadRetryTimeout, func() *resource.RetryError {
+		var errRet error
+		zone, errRet = pvtDnsService.DescribePrivateZoneById(ctx, zoneId)
+		if errRet != nil {
+			return common2.RetryError(ctx, errRet, common2.InternalServerError)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if zone == nil {
+		d.SetId("")
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Private DNS zone doesn't exist",
+			Detail:   fmt.Sprintf("The private DNS zone %s is not exist", zoneId),
+		})
+		return diags
+	}
+
+	// drop the records removed out of band, they are registered again by the next apply
+	registered := flattenInstanceRegistrationRecords(d.Get("records").(*schema.Set))
+	existing, err := describeInstanceRegistrationZoneRecords(ctx, &pvtDnsService, registered)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	records := make([]interface{}, 0, len(registered))
+	for _, r := range registered {
+		if record, ok := existing[r.key()]; ok && r.ownedBy(record) {
+			records = append(records, r.toMap())
+		}
+	}
+	_ = d.Set("records", records)
+
+	return diags
+}
+
+func resourceZenlayerCloudPvtdnsInstanceRegistrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer common2.LogElapsed(ctx, "resource.zenlayercloud_zdns_instance_registration.delete")()
+
+	pvtDnsService := ZdnsService{
+		client: meta.(*connectivity.ZenlayerCloudClient),
+	}
+
+	registered := flattenInstanceRegistrationRecords(d.Get("records").(*schema.Set))
+	if err := deleteInstanceRegistrationRecords(ctx, &pvtDnsService, registered, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// applyInstanceRegistration registers the records of the current instances and deletes the previously registered
+// records which are no longer needed. It returns the registered records.
+func applyInstanceRegistration(ctx context.Context, pvtDnsService *ZdnsService, d *schema.ResourceData, previous []instanceRegistrationRecord, timeout time.Duration) ([]interface{}, error) {
+	// apply the records planned by instanceRegistrationRecordsDiffFunc, unless they were unknown at plan time
+	var desired []instanceRegistrationRecord
+	if plan := d.GetRawPlan(); !plan.IsNull() && plan.GetAttr("records").IsKnown() {
+		desired = flattenInstanceRegistrationRecords(d.Get("records").(*schema.Set))
+	} else {
+		var err error
+		desired, err = describeInstanceRegistrationRecords(ctx, pvtDnsService, d)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	desiredKeys := make(map[string]bool, len(desired))
+	for _, r := range desired {
+		desiredKeys[r.key()] = true
+	}
+	var stale []instanceRegistrationRecord
+	for _, r := range previous {
+		if !desiredKeys[r.key()] {
+			stale = append(stale, r)
+		}
+	}
+	if err := deleteInstanceRegistrationRecords(ctx, pvtDnsService, stale, timeout); err != nil {
+		return nil, err
+	}
+
+	existing, err := describeInstanceRegistrationZoneRecords(ctx, pvtDnsService, desired)
+	if err != nil {
+		return nil, err
+	}
+	ttl := d.Get("ttl").(int)
+	records := make([]interface{}, 0, len(desired))
+	for _, r := range desired {
+		record, ok := existing[r.key()]
+		if ok && !r.ownedBy(record) {
+			return nil, fmt.Errorf("`%s` record `%s` with value %s already exists in private zone %s and is not registered for instance %s, please remove it first",
+				r.Type, r.RecordName, r.Value, r.ZoneId, r.InstanceId)
+		}
+		if ok && (ttl == 0 || (record.Ttl != nil && *record.Ttl == ttl)) {
+			records = append(records, r.toMap())
+			continue
+		}
+
+		if ok {
+			request := pvtdns.NewModifyPrivateZoneRecordRequest()
+			request.ZoneId = common.String(r.ZoneId)
+			request.RecordId = record.RecordId
+			request.Value = record.Value
+			request.Remark = record.Remark
+			request.Weight = record.Weight
+			request.Ttl = common.Integer(ttl)
+			err = resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+				response, errRet := pvtDnsService.client.WithZDnsClient().ModifyPrivateZoneRecord(request)
+				defer common2.LogApiRequest(ctx, "ModifyPrivateZoneRecord", request, response, errRet)
+				if errRet != nil {
+					return common2.RetryError(ctx, errRet, common2.InternalServerError, common.NetworkError)
+				}
+				return nil
+			})
+			if err != nil {
+				return nil, fmt.Errorf("error modifying record %s of private zone %s: %w", common.ToString(record.RecordId), r.ZoneId, err)
+			}
+			records = append(records, r.toMap())
+			continue
+		}
+
+		request := pvtdns.NewAddPrivateZoneRecordRequest()
+		request.ZoneId = common.String(r.ZoneId)
+		request.RecordName = common.String(r.RecordName)
+		request.Type = common.String(r.Type)
+		request.Line = common.String(defaultRecordLine)
+		request.Value = common.String(r.Value)
+		request.Remark = common.String(r.InstanceId)
+		if ttl != 0 {
+			request.Ttl = common.Integer(ttl)
+		}
+		err = resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+			response, errRet := pvtDnsService.client.WithZDnsClient().AddPrivateZoneRecord(request)
+			defer common2.LogApiRequest(ctx, "AddPrivateZoneRecord", request, response, errRet)
+			if errRet != nil {
+				return common2.RetryError(ctx, errRet, common2.InternalServerError, common.NetworkError)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error adding `%s` record `%s` of instance %s to private zone %s: %w", r.Type, r.RecordName, r.InstanceId, r.ZoneId, err)
+		}
+		records = append(records, r.toMap())
+	}
+	return records, nil
+}
+
+// describeInstanceRegistrationRecords returns the records expected for the instances currently matching the selector.
+func describeInstanceRegistrationRecords(ctx context.Context, pvtDnsService *ZdnsService, d instanceRegistrationGetter) ([]instanceRegistrationRecord, error) {
+	zoneId := d.Get("zone_id").(string)
+	ptrZoneId := d.Get("ptr_zone_id").(string)
+
+	zoneName, err := describeInstanceRegistrationZoneName(ctx, pvtDnsService, zoneId)
+	if err != nil {
+		return nil, err
+	}
+	ptrZoneName := ""
+	if ptrZoneId != "" {
+		ptrZoneName, err = describeInstanceRegistrationZoneName(ctx, pvtDnsService, ptrZoneId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	filter := &zec.ZecInstancesFilter{}
+	if v, ok := d.Get("instance_ids").(*schema.Set); ok && v.Len() > 0 {
+		filter.InstancesIds = common2.ToStringList(v.List())
+	}
+	if v, ok := d.Get("instance_tags").(map[string]interface{}); ok && len(v) > 0 {
+		filter.Tags = make(map[string]string, len(v))
+		for key, value := range v {
+			filter.Tags[key] = value.(string)
+		}
+	}
+
+	zecService := zec.NewZecService(pvtDnsService.client)
+	var instances []*zecInstance
+	err = resource.RetryContext(ctx, common2.ReadRetryTimeout, func() *resource.RetryError {
+		result, errRet := zecService.DescribeInstancesByFilter(filter)
+		if errRet != nil {
+			return common2.RetryError(ctx, errRet, common2.InternalServerError)
+		}
+		instances = make([]*zecInstance, 0, len(result))
+		for _, instance := range result {
+			instances = append(instances, newZecInstance(instance))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	template := d.Get("name_template").(string)
+	enableIpv6 := d.Get("enable_ipv6").(bool)
+	var records []instanceRegistrationRecord
+	for _, instance := range instances {
+		if !instance.registrable() {
+			continue
+		}
+		name := renderInstanceRecordName(template, instance, zoneName)
+		fqdn := toFqdn(name, toFqdn(zoneName, "."))
+
+		addresses := map[string]string{}
+		if instance.ipv4 != "" {
+			addresses["A"] = instance.ipv4
+		}
+		if enableIpv6 && instance.ipv6 != "" {
+			addresses["AAAA"] = instance.ipv6
+		}
+		for recordType, ip := range addresses {
+			records = append(records, instanceRegistrationRecord{
+				InstanceId: instance.id,
+				ZoneId:     zoneId,
+				RecordName: name,
+				Type:       recordType,
+				Value:      ip,
+			})
+			if ptrZoneId == "" {
+				continue
+			}
+			ptrName, err := relativeRecordName(reverseAddressName(net.ParseIP(ip)), toFqdn(ptrZoneName, "."))
+			if err != nil {
+				continue
+			}
+			records = append(records, instanceRegistrationRecord{
+				InstanceId: instance.id,
+				ZoneId:     ptrZoneId,
+				RecordName: ptrName,
+				Type:       "PTR",
+				Value:      strings.TrimSuffix(fqdn, "."),
+			})
+		}
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].key() < records[j].key()
+	})
+	return records, nil
+}
+
+func describeInstanceRegistrationZoneName(ctx context.Context, pvtDnsService *ZdnsService, zoneId string) (string, error) {
+	var zone *pvtdns.PrivateZone
+	err := resource.RetryContext(ctx, common2.ReadRetryTimeout, func() *resource.RetryError {
+		var errRet error
+		zone, errRet = pvtDnsService.DescribePrivateZoneById(ctx, zoneId)
+		if errRet != nil {
+			return common2.RetryError(ctx, errRet, common2.InternalServerError)
+		}
+		if zone == nil {
+			return resource.NonRetryableError(fmt.Errorf("private zone %s is not exist", zoneId))
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return common.ToString(zone.ZoneName), nil
+}
+
+// describeInstanceRegistrationZoneRecords returns the records of the zones of the given records, keyed as instanceRegistrationRecord.
+func describeInstanceRegistrationZoneRecords(ctx context.Context, pvtDnsService *ZdnsService, records []instanceRegistrationRecord) (map[string]*pvtdns.PrivateZoneRecord, error) {
+	zoneIds := make(map[string]bool)
+	for _, r := range records {
+		zoneIds[r.ZoneId] = true
+	}
+
+	existing := make(map[string]*pvtdns.PrivateZoneRecord)
+	for zoneId := range zoneIds {
+		var result []*pvtdns.PrivateZoneRecord
+		err := resource.RetryContext(ctx, common2.ReadRetryTimeout, func() *resource.RetryError {
+			var errRet error
+			result, errRet = pvtDnsService.DescribePrivateZoneRecordsByFilter(ctx, &PrivateRecordFilter{ZoneId: zoneId, Line: defaultRecordLine})
+			if errRet != nil {
+				return common2.RetryError(ctx, errRet, common2.InternalServerError)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, record := range result {
+			r := toZoneFileRecord(record)
+			existing[instanceRegistrationRecord{ZoneId: zoneId, RecordName: r.RecordName, Type: r.Type, Value: r.Value}.key()] = record
+		}
+	}
+	return existing, nil
+}
+
+func deleteInstanceRegistrationRecords(ctx context.Context, pvtDnsService *ZdnsService, records []instanceRegistrationRecord, timeout time.Duration) error {
+	if len(records) == 0 {
+		return nil
+	}
+	existing, err := describeInstanceRegistrationZoneRecords(ctx, pvtDnsService, records)
+	if err != nil {
+		return err
+	}
+
+	recordIds := make(map[string][]string)
+	for _, r := range records {
+		if record, ok := existing[r.key()]; ok && r.ownedBy(record) {
+			recordIds[r.ZoneId] = append(recordIds[r.ZoneId], common.ToString(record.RecordId))
+		}
+	}
+	for zoneId, ids := range recordIds {
+		err = resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+			errRet := pvtDnsService.DeletePrivateDnsRecordsByIds(ctx, zoneId, ids)
+			if errRet != nil {
+				return common2.RetryError(ctx, errRet, common2.InternalServerError, common.NetworkError)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("error deleting records %v of private zone %s: %w", ids, zoneId, err)
+		}
+	}
+	return nil
+}
+
+func flattenInstanceRegistrationRecords(set *schema.Set) []instanceRegistrationRecord {
+	records := make([]instanceRegistrationRecord, 0, set.Len())
+	for _, v := range set.List() {
+		item := v.(map[string]interface{})
+		records = append(records, instanceRegistrationRecord{
+			InstanceId: item["instance_id"].(string),
+			ZoneId:     item["zone_id"].(string),
+			RecordName: item["record_name"].(string),
+			Type:       item["type"].(string),
+			Value:      item["value"].(string),
+		})
+	}
+	return records
+}
+
+func sameInstanceRegistrationRecords(a []instanceRegistrationRecord, b []instanceRegistrationRecord) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	records := make(map[instanceRegistrationRecord]bool, len(a))
+	for _, r := range a {
+		records[r] = true
+	}
+	for _, r := range b {
+		if !records[r] {
+			return false
+		}
+	}
+	return true
+}
+
+func renderInstanceRecordName(template string, instance *zecInstance, zoneName string) string {
+	instanceName := strings.Trim(invalidRecordNameChars.ReplaceAllString(strings.ToLower(instance.name), "-"), "-.")
+	if instanceName == "" {
+		instanceName = strings.ToLower(instance.id)
+	}
+	name := strings.NewReplacer(
+		"{instance_name}", instanceName,
+		"{instance_id}", strings.ToLower(instance.id),
+		"{zone}", strings.TrimSuffix(strings.ToLower(zoneName), "."),
+	).Replace(template)
+
+	zone := toFqdn(zoneName, ".")
+	if relative, err := relativeRecordName(toFqdn(strings.TrimSuffix(name, ".")+".", "."), zone); err == nil {
+		return relative
+	}
+	return strings.TrimSuffix(name, ".")
+}
+
+// reverseAddressName returns the in-addr.arpa or ip6.arpa name of the address.
+func reverseAddressName(ip net.IP) string {
+	if ip4 := ip.To4(); ip4 != nil {
+		return fmt.Sprintf("%d.%d.%d.%d.in-addr.arpa.", ip4[3], ip4[2], ip4[1], ip4[0])
+	}
+	ip16 := ip.To16()
+	if ip16 == nil {
+		return ""
+	}
+	var b strings.Builder
+	for i := len(ip16) - 1; i >= 0; i-- {
+		b.WriteString(fmt.Sprintf("%x.%x.", ip16[i]&0x0f, ip16[i]>>4))
+	}
+	b.WriteString("ip6.arpa.")
+	return b.String()
+}
@@ -0,0 +1,87 @@
+package zdns
+
+import (
+	"net"
+	"testing"
+
+	"github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/common"
+	pvtdns "github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/zdns20251101"
+)
+
+func TestRenderInstanceRecordName(t *testing.T) {
+	cases := []struct {
+		name     string
+		template string
+		instance *zecInstance
+		expect   string
+	}{
+		{"instance name", "{instance_name}", &zecInstance{id: "ins-1", name: "Web_Server 01"}, "web-server-01"},
+		{"instance id", "{instance_id}.hosts", &zecInstance{id: "INS-1", name: "web"}, "ins-1.hosts"},
+		{"empty instance name", "{instance_name}", &zecInstance{id: "ins-1", name: "__"}, "ins-1"},
+		{"fqdn in zone", "{instance_name}.{zone}.", &zecInstance{id: "ins-1", name: "web"}, "web"},
+		{"zone apex", "{zone}", &zecInstance{id: "ins-1", name: "web"}, "@"},
+		{"out of zone", "{instance_name}.example.net.", &zecInstance{id: "ins-1", name: "web"}, "web.example.net"},
+	}
+	for _, c := range cases {
+		if name := renderInstanceRecordName(c.template, c.instance, "Example.com"); name != c.expect {
+			t.Errorf("%s: expect %s, got %s", c.name, c.expect, name)
+		}
+	}
+}
+
+func TestReverseAddressName(t *testing.T) {
+	cases := map[string]string{
+		"192.168.0.1":        "1.0.168.192.in-addr.arpa.",
+		"2001:db8::567:89ab": "b.a.9.8.7.6.5.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa.",
+	}
+	for ip, expect := range cases {
+		if name := reverseAddressName(net.ParseIP(ip)); name != expect {
+			t.Errorf("%s: expect %s, got %s", ip, expect, name)
+		}
+	}
+	if name := reverseAddressName(nil); name != "" {
+		t.Errorf("expect empty name for invalid address, got %s", name)
+	}
+}
+
+func TestSameInstanceRegistrationRecords(t *testing.T) {
+	a := instanceRegistrationRecord{InstanceId: "ins-1", ZoneId: "zone-1", RecordName: "web", Type: "A", Value: "192.168.0.1"}
+	b := instanceRegistrationRecord{InstanceId: "ins-2", ZoneId: "zone-1", RecordName: "db", Type: "A", Value: "192.168.0.2"}
+	c := b
+	c.Value = "192.168.0.3"
+
+	cases := []struct {
+		name   string
+		x, y   []instanceRegistrationRecord
+		expect bool
+	}{
+		{"both empty", nil, []instanceRegistrationRecord{}, true},
+		{"same order", []instanceRegistrationRecord{a, b}, []instanceRegistrationRecord{a, b}, true},
+		{"different order", []instanceRegistrationRecord{a, b}, []instanceRegistrationRecord{b, a}, true},
+		{"different length", []instanceRegistrationRecord{a}, []instanceRegistrationRecord{a, b}, false},
+		{"different value", []instanceRegistrationRecord{a, b}, []instanceRegistrationRecord{a, c}, false},
+	}
+	for _, tc := range cases {
+		if same := sameInstanceRegistrationRecords(tc.x, tc.y); same != tc.expect {
+			t.Errorf("%s: expect %v, got %v", tc.name, tc.expect, same)
+		}
+	}
+}
+
+func TestInstanceRegistrationRecordOwnedBy(t *testing.T) {
+	r := instanceRegistrationRecord{InstanceId: "ins-1", ZoneId: "zone-1", RecordName: "web", Type: "A", Value: "192.168.0.1"}
+	cases := []struct {
+		remark *string
+		expect bool
+	}{
+		{common.String("ins-1"), true},
+		{common.String("ins-2"), false},
+		{common.String(""), false},
+		{nil, false},
+	}
+	for _, c := range cases {
+		if owned := r.ownedBy(&pvtdns.PrivateZoneRecord{Remark: c.remark}); owned != c.expect {
+			t.Errorf("remark %v: expect %v, got %v", common.ToString(c.remark), c.expect, owned)
+		}
+	}
+}
@@ -0,0 +1,527 @@
+package zdns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	common2 "github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/common"
+	"github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/connectivity"
+	"github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/common"
+	pvtdns "github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/zdns20251101"
+)
+
+const defaultRecordLine = "default"
+
+func ResourceZenlayerCloudPvtdnsRecordSet() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceZenlayerCloudPvtdnsRecordSetCreate,
+		ReadContext:   resourceZenlayerCloudPvtdnsRecordSetRead,
+		UpdateContext: resourceZenlayerCloudPvtdnsRecordSetUpdate,
+		DeleteContext: resourceZenlayerCloudPvtdnsRecordSetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: recordSetValuesValidateFunc,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the private zone.",
+			},
+			"record_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the record set. such as `www`, `@`.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"A", "AAAA", "CNAME", "MX", "TXT", "PTR", "SRV"}, false),
+				Description:  "DNS record type. Valid values: `A`, `AAAA`, `CNAME`, `MX`, `TXT`, `PTR`, `SRV`.",
+			},
+			"line": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultRecordLine,
+				ForceNew:    true,
+				Description: "The resolver line. Default is `default`. Also valid for specified region, such as `asia-east-1`.",
+			},
+			"values": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "The values of the record set. Records of the same name, type and line which are not listed here are deleted. Only one value is allowed when `type` is `CNAME`.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The value of the record.",
+						},
+						"weight": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntBetween(1, 100),
+							Description:  "Weight of the record. Only takes effect for type `A` or `AAAA`. Range: [1, 100], default: 1.",
+						},
+						"priority": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 99),
+							Description:  "MX priority, which is required when the record type is `MX`. Range: [1, 99].",
+						},
+					},
+				},
+			},
+			"ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(5, 86400),
+				Description:  "The ttl of the records. Measured in second. Range: [5,86400], default: 60.",
+			},
+			"remark": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Remarks for the records.",
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Enabled", "Disabled"}, false),
+				Description:  "Status of the records. Valid values: `Enabled`, `Disabled`.",
+			},
+			"record_ids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The record IDs of the record set, keyed by record value.",
+			},
+		},
+	}
+}
+
+type recordSetValue struct {
+	value    string
+	weight   int
+	priority int
+}
+
+func recordSetValuesValidateFunc(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	recordType := diff.Get("type").(string)
+	valueSet := diff.Get("values").(*schema.Set)
+	if recordType == "CNAME" && valueSet.Len() > 1 {
+		return fmt.Errorf("only one value is allowed in `values` when `type` is `CNAME`, got %d", valueSet.Len())
+	}
+	values := make(map[string]bool)
+	for _, v := range valueSet.List() {
+		item := v.(map[string]interface{})
+		value := item["value"].(string)
+		if values[value] {
+			return fmt.Errorf("value `%s` is duplicated in `values`", value)
+		}
+		values[value] = true
+
+		if recordType == "MX" && item["priority"].(int) == 0 {
+			return fmt.Errorf("`priority` is required for value `%s` when `type` is `MX`", value)
+		}
+		if recordType != "MX" && item["priority"].(int) != 0 {
+			return fmt.Errorf("`priority` can only be set when `type` is `MX`")
+		}
+	}
+	return nil
+}
+
+func expandRecordSetValues(d *schema.ResourceData) map[string]recordSetValue {
+	values := make(map[string]recordSetValue)
+	for _, v := range d.Get("values").(*schema.Set).List() {
+		item := v.(map[string]interface{})
+		value := item["value"].(string)
+		values[value] = recordSetValue{
+			value:    value,
+			weight:   item["weight"].(int),
+			priority: item["priority"].(int),
+		}
+	}
+	return values
+}
+
+func recordSetLine(d *schema.ResourceData) string {
+	if v, ok := d.GetOk("line"); ok && v.(string) != "" {
+		return v.(string)
+	}
+	return defaultRecordLine
+}
+
+// parseRecordSetId parses the ID in format `zone_id:record_name:type[:line]`.
+// The line of ID without it is taken from the state, which is `default` on import.
+func parseRecordSetId(d *schema.ResourceData) (zoneId, recordName, recordType, line string, err error) {
+	id := strings.Split(d.Id(), ":")
+	switch len(id) {
+	case 3:
+		return id[0], id[1], id[2], recordSetLine(d), nil
+	case 4:
+		if id[3] == "" {
+			id[3] = defaultRecordLine
+		}
+		return id[0], id[1], id[2], id[3], nil
+	default:
+		return "", "", "", "", fmt.Errorf("invalid record set ID %s, expected format `zone_id:record_name:type[:line]`", d.Id())
+	}
+}
+
+func recordTypeSupportWeight(recordType string) bool {
+	return recordType == "A" || recordType == "AAAA"
+}
+
+func resourceZenlayerCloudPvtdnsRecordSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer common2.LogElapsed(ctx, "resource.zenlayercloud_zdns_record_set.create")()
+
+	pvtDnsService := ZdnsService{
+		client: meta.(*connectivity.ZenlayerCloudClient),
+	}
+	zoneId := d.Get("zone_id").(string)
+	recordName := d.Get("record_name").(string)
+	recordType := d.Get("type").(string)
+
+	var records []*pvtdns.PrivateZoneRecord
+	err := resource.RetryContext(ctx, common2.ReadRetryTimeout, func() *resource.RetryError {
+		var errRet error
+		records, errRet = pvtDnsService.DescribePrivateZoneRecordSet(ctx, zoneId, recordName, recordType, recordSetLine(d))
+		if errRet != nil {
+			return common2.RetryError(ctx, errRet, common2.InternalServerError)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(records) > 0 {
+		return diag.Errorf("%d `%s` record(s) of `%s` already exist in private zone %s, please import them with `terraform import`", len(records), recordType, recordName, zoneId)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s:%s:%s", zoneId, recordName, recordType, recordSetLine(d)))
+
+	if err := applyPvtdnsRecordSet(ctx, d, &pvtDnsService, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceZenlayerCloudPvtdnsRecordSetRead(ctx, d, meta)
+}
+
+func resourceZenlayerCloudPvtdnsRecordSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer common2.LogElapsed(ctx, "resource.zenlayercloud_zdns_record_set.update")()
+
+	pvtDnsService := ZdnsService{
+		client: meta.(*connectivity.ZenlayerCloudClient),
+	}
+
+	if err := applyPvtdnsRecordSet(ctx, d, &pvtDnsService, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceZenlayerCloudPvtdnsRecordSetRead(ctx, d, meta)
+}
+
+// applyPvtdnsRecordSet diffs the configured values against the existing records one by one,
+// deleting the records not configured, adding the missing ones and modifying the changed ones.
+func applyPvtdnsRecordSet(ctx context.Context, d *schema.ResourceData, pvtDnsService *ZdnsService, timeout time.Duration) error {
+	zoneId := d.Get("zone_id").(string)
+	recordName := d.Get("record_name").(string)
+	recordType := d.Get("type").(string)
+	line := recordSetLine(d)
+	ttl := d.Get("ttl").(int)
+	remark := d.Get("remark").(string)
+	status := d.Get("status").(string)
+
+	var records []*pvtdns.PrivateZoneRecord
+	err := resource.RetryContext(ctx, common2.ReadRetryTimeout, func() *resource.RetryError {
+		var errRet error
+		records, errRet = pvtDnsService.DescribePrivateZoneRecordSet(ctx, zoneId, recordName, recordType, line)
+		if errRet != nil {
+			return common2.RetryError(ctx, errRet, common2.InternalServerError)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	desired := expandRecordSetValues(d)
+	existing := make(map[string]*pvtdns.PrivateZoneRecord)
+	var staleIds []string
+	for _, record := range records {
+		value := common.ToString(record.Value)
+		if _, ok := desired[value]; !ok {
+			staleIds = append(staleIds, common.ToString(record.RecordId))
+			continue
+		}
+		if _, ok := existing[value]; ok {
+			// duplicated value out of band, keep only one of them
+			staleIds = append(staleIds, common.ToString(record.RecordId))
+			continue
+		}
+		existing[value] = record
+	}
+
+	if len(staleIds) > 0 {
+		err = resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+			errRet := pvtDnsService.DeletePrivateDnsRecordsByIds(ctx, zoneId, staleIds)
+			if errRet != nil {
+				return common2.RetryError(ctx, errRet, common2.InternalServerError, common.NetworkError)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("error deleting records %v of private zone %s: %w", staleIds, zoneId, err)
+		}
+	}
+
+	var recordIds []string
+	for value, item := range desired {
+		record, ok := existing[value]
+		if !ok {
+			request := pvtdns.NewAddPrivateZoneRecordRequest()
+			request.ZoneId = &zoneId
+			request.RecordName = &recordName
+			request.Type = &recordType
+			request.Line = &line
+			request.Value = common.String(value)
+			if recordTypeSupportWeight(recordType) {
+				request.Weight = common.Integer(item.weight)
+			}
+			if item.priority != 0 {
+				request.Priority = common.Integer(item.priority)
+			}
+			if ttl != 0 {
+				request.Ttl = common.Integer(ttl)
+			}
+			if remark != "" {
+				request.Remark = common.String(remark)
+			}
+			if status != "" {
+				request.Status = common.String(status)
+			}
+
+			err = resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+				response, errRet := pvtDnsService.client.WithZDnsClient().AddPrivateZoneRecord(request)
+				defer common2.LogApiRequest(ctx, "AddPrivateZoneRecord", request, response, errRet)
+				if errRet != nil {
+					return common2.RetryError(ctx, errRet, common2.InternalServerError, common.NetworkError)
+				}
+				if response.Response.RecordId == nil {
+					return resource.NonRetryableError(fmt.Errorf("private zone record id is nil"))
+				}
+				recordIds = append(recordIds, *response.Response.RecordId)
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("error adding record `%s` to private zone %s: %w", value, zoneId, err)
+			}
+			continue
+		}
+
+		recordId := common.ToString(record.RecordId)
+		recordIds = append(recordIds, recordId)
+		if !pvtdnsRecordChanged(record, item, recordType, ttl, remark) {
+			continue
+		}
+		request := pvtdns.NewModifyPrivateZoneRecordRequest()
+		request.ZoneId = &zoneId
+		request.RecordId = common.String(recordId)
+		request.Value = common.String(value)
+		request.Remark = common.String(remark)
+		if recordTypeSupportWeight(recordType) {
+			request.Weight = common.Integer(item.weight)
+		}
+		if item.priority != 0 {
+			request.Priority = common.Integer(item.priority)
+		}
+		if ttl != 0 {
+			request.Ttl = common.Integer(ttl)
+		}
+
+		err = resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+			response, errRet := pvtDnsService.client.WithZDnsClient().ModifyPrivateZoneRecord(request)
+			defer common2.LogApiRequest(ctx, "ModifyPrivateZoneRecord", request, response, errRet)
+			if errRet != nil {
+				return common2.RetryError(ctx, errRet, common2.InternalServerError, common.NetworkError)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("error modifying record %s of private zone %s: %w", recordId, zoneId, err)
+		}
+	}
+
+	var statusChangedIds []string
+	for _, record := range existing {
+		if status != "" && common.ToString(record.Status) != status {
+			statusChangedIds = append(statusChangedIds, common.ToString(record.RecordId))
+		}
+	}
+	if len(statusChangedIds) > 0 {
+		request := pvtdns.NewModifyPrivateZoneRecordsStatusRequest()
+		request.ZoneId = &zoneId
+		request.RecordIds = statusChangedIds
+		request.Status = common.String(status)
+
+		err = resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+			response, errRet := pvtDnsService.client.WithZDnsClient().ModifyPrivateZoneRecordsStatus(request)
+			defer common2.LogApiRequest(ctx, "ModifyPrivateZoneRecordsStatus", request, response, errRet)
+			if errRet != nil {
+				return common2.RetryError(ctx, errRet, common2.InternalServerError, common.NetworkError)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("error modifying status of records %v of private zone %s: %w", statusChangedIds, zoneId, err)
+		}
+	}
+
+	return nil
+}
+
+func pvtdnsRecordChanged(record *pvtdns.PrivateZoneRecord, item recordSetValue, recordType string, ttl int, remark string) bool {
+	if recordTypeSupportWeight(recordType) && record.Weight != nil && *record.Weight != item.weight {
+		return true
+	}
+	if item.priority != 0 && (record.Priority == nil || *record.Priority != item.priority) {
+		return true
+	}
+	if ttl != 0 && (record.Ttl == nil || *record.Ttl != ttl) {
+		return true
+	}
+	return common.ToString(record.Remark) != remark
+}
+
+func resourceZenlayerCloudPvtdnsRecordSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer common2.LogElapsed(ctx, "resource.zenlayercloud_zdns_record_set.read")()
+
+	var diags diag.Diagnostics
+
+	zoneId, recordName, recordType, line, err := parseRecordSetId(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	pvtDnsService := ZdnsService{
+		client: meta.(*connectivity.ZenlayerCloudClient),
+	}
+
+	var records []*pvtdns.PrivateZoneRecord
+	err = resource.RetryContext(ctx, common2.ReadRetryTimeout, func() *resource.RetryError {
+		var errRet error
+		records, errRet = pvtDnsService.DescribePrivateZoneRecordSet(ctx, zoneId, recordName, recordType, line)
+		if errRet != nil {
+			return common2.RetryError(ctx, errRet, common2.InternalServerError)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(records) == 0 {
+		d.SetId("")
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Private DNS record set doesn't exist",
+			Detail:   fmt.Sprintf("The private DNS record set %s is not exist", d.Id()),
+		})
+		return diags
+	}
+
+	values := make([]map[string]interface{}, 0, len(records))
+	recordIds := make(map[string]interface{}, len(records))
+	for _, record := range records {
+		value := map[string]interface{}{
+			"value":    common.ToString(record.Value),
+			"weight":   1,
+			"priority": 0,
+		}
+		if recordTypeSupportWeight(recordType) && record.Weight != nil {
+			value["weight"] = *record.Weight
+		}
+		if recordType == "MX" && record.Priority != nil {
+			value["priority"] = *record.Priority
+		}
+		values = append(values, value)
+		recordIds[common.ToString(record.Value)] = common.ToString(record.RecordId)
+	}
+
+	_ = d.Set("zone_id", zoneId)
+	_ = d.Set("record_name", recordName)
+	_ = d.Set("type", recordType)
+	_ = d.Set("line", line)
+	_ = d.Set("values", values)
+	_ = d.Set("ttl", records[0].Ttl)
+	_ = d.Set("remark", records[0].Remark)
+	_ = d.Set("status", records[0].Status)
+	_ = d.Set("record_ids", recordIds)
+
+	return diags
+}
+
+func resourceZenlayerCloudPvtdnsRecordSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer common2.LogElapsed(ctx, "resource.zenlayercloud_zdns_record_set.delete")()
+
+	pvtDnsService := ZdnsService{
+		client: meta.(*connectivity.ZenlayerCloudClient),
+	}
+	zoneId, recordName, recordType, line, err := parseRecordSetId(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var records []*pvtdns.PrivateZoneRecord
+	err = resource.RetryContext(ctx, common2.ReadRetryTimeout, func() *resource.RetryError {
+		var errRet error
+		records, errRet = pvtDnsService.DescribePrivateZoneRecordSet(ctx, zoneId, recordName, recordType, line)
+		if errRet != nil {
+			return common2.RetryError(ctx, errRet, common2.InternalServerError)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(records) == 0 {
+		return nil
+	}
+
+	recordIds := make([]string, 0, len(records))
+	for _, record := range records {
+		recordIds = append(recordIds, common.ToString(record.RecordId))
+	}
+
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete)-time.Minute, func() *resource.RetryError {
+		errRet := pvtDnsService.DeletePrivateDnsRecordsByIds(ctx, zoneId, recordIds)
+		if errRet != nil {
+			return common2.RetryError(ctx, errRet, common2.InternalServerError, common.NetworkError)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
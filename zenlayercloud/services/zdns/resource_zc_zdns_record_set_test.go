@@ -0,0 +1,43 @@
+package zdns
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestParseRecordSetId(t *testing.T) {
+	cases := []struct {
+		id        string
+		line      string
+		expect    []string
+		expectErr bool
+	}{
+		{id: "zone-1:www:A", expect: []string{"zone-1", "www", "A", "default"}},
+		{id: "zone-1:www:A", line: "asia-east-1", expect: []string{"zone-1", "www", "A", "asia-east-1"}},
+		{id: "zone-1:www:A:asia-east-1", expect: []string{"zone-1", "www", "A", "asia-east-1"}},
+		{id: "zone-1:www:A:", expect: []string{"zone-1", "www", "A", "default"}},
+		{id: "zone-1:www", expectErr: true},
+		{id: "zone-1:www:A:default:1", expectErr: true},
+	}
+	for _, c := range cases {
+		raw := map[string]interface{}{}
+		if c.line != "" {
+			raw["line"] = c.line
+		}
+		d := schema.TestResourceDataRaw(t, ResourceZenlayerCloudPvtdnsRecordSet().Schema, raw)
+		d.SetId(c.id)
+		zoneId, recordName, recordType, line, err := parseRecordSetId(d)
+		if (err != nil) != c.expectErr {
+			t.Errorf("%s: expect error %v, got %v", c.id, c.expectErr, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if got := []string{zoneId, recordName, recordType, line}; !reflect.DeepEqual(got, c.expect) {
+			t.Errorf("%s: expect %v, got %v", c.id, c.expect, got)
+		}
+	}
+}
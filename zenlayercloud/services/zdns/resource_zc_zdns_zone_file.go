@@ -0,0 +1,363 @@
+package zdns
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	common2 "github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/common"
+	"github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/connectivity"
+	"github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/common"
+	pvtdns "github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/zdns20251101"
+)
+
+func ResourceZenlayerCloudPvtdnsZoneFile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceZenlayerCloudPvtdnsZoneFileCreate,
+		ReadContext:   resourceZenlayerCloudPvtdnsZoneFileRead,
+		UpdateContext: resourceZenlayerCloudPvtdnsZoneFileUpdate,
+		DeleteContext: resourceZenlayerCloudPvtdnsZoneFileDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the private zone.",
+			},
+			"content": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The zone file text in RFC 1035 format. Supported record types: `A`, `AAAA`, `CNAME`, `MX`, `TXT`, `SRV`, `PTR`. Supported directives: `$ORIGIN`, `$TTL`. `SOA` and `NS` records are ignored. TTL values must be in range [5, 86400].",
+			},
+			"zone_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the private zone.",
+			},
+			"record_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of records managed by the zone file.",
+			},
+		},
+	}
+}
+
+func resourceZenlayerCloudPvtdnsZoneFileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer common2.LogElapsed(ctx, "resource.zenlayercloud_zdns_zone_file.create")()
+
+	pvtDnsService := ZdnsService{
+		client: meta.(*connectivity.ZenlayerCloudClient),
+	}
+	zoneId := d.Get("zone_id").(string)
+
+	if err := applyPvtdnsZoneFile(ctx, &pvtDnsService, zoneId, d.Get("content").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(zoneId)
+
+	return resourceZenlayerCloudPvtdnsZoneFileRead(ctx, d, meta)
+}
+
+func resourceZenlayerCloudPvtdnsZoneFileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer common2.LogElapsed(ctx, "resource.zenlayercloud_zdns_zone_file.update")()
+
+	pvtDnsService := ZdnsService{
+		client: meta.(*connectivity.ZenlayerCloudClient),
+	}
+
+	if d.HasChange("content") {
+		if err := applyPvtdnsZoneFile(ctx, &pvtDnsService, d.Id(), d.Get("content").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceZenlayerCloudPvtdnsZoneFileRead(ctx, d, meta)
+}
+
+func resourceZenlayerCloudPvtdnsZoneFileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer common2.LogElapsed(ctx, "resource.zenlayercloud_zdns_zone_file.read")()
+
+	var diags diag.Diagnostics
+
+	pvtDnsService := ZdnsService{
+		client: meta.(*connectivity.ZenlayerCloudClient),
+	}
+	zoneId := d.Id()
+
+	zone, records, err := describePvtdnsZoneFileRecords(ctx, &pvtDnsService, zoneId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if zone == nil {
+		d.SetId("")
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Private DNS zone doesn't exist",
+			Detail:   fmt.Sprintf("The private DNS zone %s is not exist", zoneId),
+		})
+		return diags
+	}
+	zoneName := common.ToString(zone.ZoneName)
+
+	// keep the content as written unless the records are drifted, so formatting and comments are preserved
+	content := d.Get("content").(string)
+	desired, err := parseZoneFile(content, zoneName)
+	if content == "" || err != nil || !sameZoneFileRecords(desired, records) {
+		content = renderZoneFile(zoneName, records)
+	}
+
+	_ = d.Set("zone_id", zoneId)
+	_ = d.Set("zone_name", zoneName)
+	_ = d.Set("content", content)
+	_ = d.Set("record_count", len(records))
+
+	return diags
+}
+
+func resourceZenlayerCloudPvtdnsZoneFileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer common2.LogElapsed(ctx, "resource.zenlayercloud_zdns_zone_file.delete")()
+
+	pvtDnsService := ZdnsService{
+		client: meta.(*connectivity.ZenlayerCloudClient),
+	}
+	zoneId := d.Id()
+
+	var zone *pvtdns.PrivateZone
+	var records []*pvtdns.PrivateZoneRecord
+	err := resource.RetryContext(ctx, common2.ReadRetryTimeout, func() *resource.RetryError {
+		var errRet error
+		zone, errRet = pvtDnsService.DescribePrivateZoneById(ctx, zoneId)
+		if errRet != nil {
+			return common2.RetryError(ctx, errRet, common2.InternalServerError)
+		}
+		if zone == nil {
+			return nil
+		}
+		records, errRet = pvtDnsService.DescribePrivateZoneRecordsByFilter(ctx, &PrivateRecordFilter{ZoneId: zoneId, Line: defaultRecordLine})
+		if errRet != nil {
+			return common2.RetryError(ctx, errRet, common2.InternalServerError)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if zone == nil {
+		return nil
+	}
+
+	managed, err := parseZoneFile(d.Get("content").(string), common.ToString(zone.ZoneName))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	keys := make(map[string]bool, len(managed))
+	for _, r := range managed {
+		keys[r.key()] = true
+	}
+	var recordIds []string
+	for _, record := range records {
+		if keys[toZoneFileRecord(record).key()] {
+			recordIds = append(recordIds, common.ToString(record.RecordId))
+		}
+	}
+	if len(recordIds) == 0 {
+		return nil
+	}
+
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete)-time.Minute, func() *resource.RetryError {
+		errRet := pvtDnsService.DeletePrivateDnsRecordsByIds(ctx, zoneId, recordIds)
+		if errRet != nil {
+			return common2.RetryError(ctx, errRet, common2.InternalServerError, common.NetworkError)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// describePvtdnsZoneFileRecords returns the private zone and its records of the `default` line,
+// which is the only line a zone file is able to express.
+func describePvtdnsZoneFileRecords(ctx context.Context, pvtDnsService *ZdnsService, zoneId string) (zone *pvtdns.PrivateZone, records []zoneFileRecord, err error) {
+	var result []*pvtdns.PrivateZoneRecord
+	err = resource.RetryContext(ctx, common2.ReadRetryTimeout, func() *resource.RetryError {
+		var errRet error
+		zone, errRet = pvtDnsService.DescribePrivateZoneById(ctx, zoneId)
+		if errRet != nil {
+			return common2.RetryError(ctx, errRet, common2.InternalServerError)
+		}
+		if zone == nil {
+			return nil
+		}
+		result, errRet = pvtDnsService.DescribePrivateZoneRecordsByFilter(ctx, &PrivateRecordFilter{ZoneId: zoneId, Line: defaultRecordLine})
+		if errRet != nil {
+			return common2.RetryError(ctx, errRet, common2.InternalServerError)
+		}
+		return nil
+	})
+	if err != nil || zone == nil {
+		return
+	}
+	for _, record := range result {
+		records = append(records, toZoneFileRecord(record))
+	}
+	return
+}
+
+func sameZoneFileRecords(desired []zoneFileRecord, actual []zoneFileRecord) bool {
+	if len(desired) != len(actual) {
+		return false
+	}
+	records := make(map[string]zoneFileRecord, len(actual))
+	for _, r := range actual {
+		records[r.key()] = r
+	}
+	for _, r := range desired {
+		a, ok := records[r.key()]
+		if !ok || a.Priority != r.Priority || (r.Ttl != 0 && a.Ttl != r.Ttl) {
+			return false
+		}
+	}
+	return true
+}
+
+// applyPvtdnsZoneFile reconciles the records of the `default` line against the zone file. Records not in the zone file
+// are deleted, missing ones are added and those with a changed ttl or MX priority are modified.
+func applyPvtdnsZoneFile(ctx context.Context, pvtDnsService *ZdnsService, zoneId string, content string, timeout time.Duration) error {
+	var zone *pvtdns.PrivateZone
+	var records []*pvtdns.PrivateZoneRecord
+	err := resource.RetryContext(ctx, common2.ReadRetryTimeout, func() *resource.RetryError {
+		var errRet error
+		zone, errRet = pvtDnsService.DescribePrivateZoneById(ctx, zoneId)
+		if errRet != nil {
+			return common2.RetryError(ctx, errRet, common2.InternalServerError)
+		}
+		if zone == nil {
+			return resource.NonRetryableError(fmt.Errorf("private zone %s is not exist", zoneId))
+		}
+		records, errRet = pvtDnsService.DescribePrivateZoneRecordsByFilter(ctx, &PrivateRecordFilter{ZoneId: zoneId, Line: defaultRecordLine})
+		if errRet != nil {
+			return common2.RetryError(ctx, errRet, common2.InternalServerError)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	desired, err := parseZoneFile(content, common.ToString(zone.ZoneName))
+	if err != nil {
+		return fmt.Errorf("error parsing zone file: %w", err)
+	}
+	desiredRecords := make(map[string]zoneFileRecord, len(desired))
+	for _, r := range desired {
+		if _, ok := desiredRecords[r.key()]; ok {
+			return fmt.Errorf("duplicate `%s` record `%s` with value `%s` in zone file", r.Type, r.RecordName, r.Value)
+		}
+		desiredRecords[r.key()] = r
+	}
+
+	existing := make(map[string]*pvtdns.PrivateZoneRecord)
+	var staleIds []string
+	for _, record := range records {
+		key := toZoneFileRecord(record).key()
+		if _, ok := desiredRecords[key]; !ok {
+			staleIds = append(staleIds, common.ToString(record.RecordId))
+			continue
+		}
+		if _, ok := existing[key]; ok {
+			staleIds = append(staleIds, common.ToString(record.RecordId))
+			continue
+		}
+		existing[key] = record
+	}
+
+	if len(staleIds) > 0 {
+		err = resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+			errRet := pvtDnsService.DeletePrivateDnsRecordsByIds(ctx, zoneId, staleIds)
+			if errRet != nil {
+				return common2.RetryError(ctx, errRet, common2.InternalServerError, common.NetworkError)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("error deleting records %v of private zone %s: %w", staleIds, zoneId, err)
+		}
+	}
+
+	for _, r := range desired {
+		record, ok := existing[r.key()]
+		if !ok {
+			request := pvtdns.NewAddPrivateZoneRecordRequest()
+			request.ZoneId = &zoneId
+			request.RecordName = common.String(r.RecordName)
+			request.Type = common.String(r.Type)
+			request.Line = common.String(defaultRecordLine)
+			request.Value = common.String(r.Value)
+			if r.Ttl != 0 {
+				request.Ttl = common.Integer(r.Ttl)
+			}
+			if r.Type == "MX" {
+				request.Priority = common.Integer(r.Priority)
+			}
+
+			err = resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+				response, errRet := pvtDnsService.client.WithZDnsClient().AddPrivateZoneRecord(request)
+				defer common2.LogApiRequest(ctx, "AddPrivateZoneRecord", request, response, errRet)
+				if errRet != nil {
+					return common2.RetryError(ctx, errRet, common2.InternalServerError, common.NetworkError)
+				}
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("error adding `%s` record `%s` to private zone %s: %w", r.Type, r.RecordName, zoneId, err)
+			}
+			continue
+		}
+
+		actual := toZoneFileRecord(record)
+		if actual.Priority == r.Priority && (r.Ttl == 0 || actual.Ttl == r.Ttl) {
+			continue
+		}
+		request := pvtdns.NewModifyPrivateZoneRecordRequest()
+		request.ZoneId = &zoneId
+		request.RecordId = record.RecordId
+		request.Value = record.Value
+		request.Remark = record.Remark
+		request.Weight = record.Weight
+		if r.Ttl != 0 {
+			request.Ttl = common.Integer(r.Ttl)
+		}
+		if r.Type == "MX" {
+			request.Priority = common.Integer(r.Priority)
+		}
+
+		err = resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+			response, errRet := pvtDnsService.client.WithZDnsClient().ModifyPrivateZoneRecord(request)
+			defer common2.LogApiRequest(ctx, "ModifyPrivateZoneRecord", request, response, errRet)
+			if errRet != nil {
+				return common2.RetryError(ctx, errRet, common2.InternalServerError, common.NetworkError)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("error modifying record %s of private zone %s: %w", common.ToString(record.RecordId), zoneId, err)
+		}
+	}
+
+	return nil
+}
@@ -50,6 +50,39 @@ func (s *ZdnsService) DeletePrivateDnsRecordById(ctx context.Context, zoneId str
 	return err
 }
 
+func (s *ZdnsService) DeletePrivateDnsRecordsByIds(ctx context.Context, zoneId string, recordIds []string) error {
+	request := zdns.NewDeletePrivateZoneRecordRequest()
+	request.ZoneId = &zoneId
+	request.RecordIds = recordIds
+	response, err := s.client.WithZDnsClient().DeletePrivateZoneRecord(request)
+	defer common.LogApiRequest(ctx, "DeletePrivateZoneRecord", request, response, err)
+	return err
+}
+
+// DescribePrivateZoneRecordSet returns the records exactly matching the name, type and line,
+// as the record name filter of DescribePrivateZoneRecords is not an exact match.
+func (s *ZdnsService) DescribePrivateZoneRecordSet(ctx context.Context, zoneId, recordName, recordType, line string) (records []*zdns.PrivateZoneRecord, err error) {
+	result, err := s.DescribePrivateZoneRecordsByFilter(ctx, &PrivateRecordFilter{
+		ZoneId:     zoneId,
+		RecordName: recordName,
+		RecordType: recordType,
+		Line:       line,
+	})
+	if err != nil {
+		return
+	}
+	for _, record := range result {
+		if common2.ToString(record.RecordName) != recordName || common2.ToString(record.Type) != recordType {
+			continue
+		}
+		if line != "" && common2.ToString(record.Line) != line {
+			continue
+		}
+		records = append(records, record)
+	}
+	return
+}
+
 func (s *ZdnsService) DescribePrivateZoneRecordById(ctx context.Context, zoneId string, recordId string) (record *zdns.PrivateZoneRecord, err error) {
 	request := zdns.NewDescribePrivateZoneRecordsRequest()
 	request.ZoneId  = &zoneId
@@ -147,7 +180,7 @@ func (s *ZdnsService) DescribePrivateZoneRecordsByFilter(ctx context.Context, fi
 			request.GetAction(), common.ToJsonString(request), err.Error())
 		return
 	}
-	if response == nil || len(response.Response.DataSet) < 1 {
+	if response == nil || response.Response == nil || len(response.Response.DataSet) < 1 {
 		return
 	}
 
@@ -161,36 +194,51 @@ func (s *ZdnsService) DescribePrivateZoneRecordsByFilter(ctx context.Context, fi
 	wg := sync.WaitGroup{}
 
 	var vpcList = make([]interface{}, num)
+	var firstErr error
+	var mu sync.Mutex
 
 	for i := 0; i < num; i++ {
 		wg.Add(1)
 		value := i
 		goFunc := func() {
+			defer wg.Done()
 			request := convertPrivateZoneRecordsRequestFilter(filter)
 
 			request.PageNum = common2.Integer(value + 2)
 			request.PageSize = &limit
 
 			response, err := s.client.WithZDnsClient().DescribePrivateZoneRecords(request)
+			mu.Lock()
+			defer mu.Unlock()
 			if err != nil {
 				log.Printf("[CRITAL] Api[%s] fail, request body [%s], error[%s]\n",
 					request.GetAction(), common.ToJsonString(request), err.Error())
+				if firstErr == nil {
+					firstErr = err
+				}
 				return
 			}
 			log.Printf("[DEBUG] Api[%s] success, request body [%s], response body [%s]\n",
 				request.GetAction(), common.ToJsonString(request), common.ToJsonString(response))
 
-			vpcList[value] = response.Response.DataSet
+			if response != nil && response.Response != nil {
+				vpcList[value] = response.Response.DataSet
+			}
 
-			wg.Done()
 			log.Printf("[DEBUG] thread %d finished", value)
 		}
 		g.Run(goFunc)
 	}
 	wg.Wait()
 
+	if firstErr != nil {
+		return nil, firstErr
+	}
 	log.Printf("[DEBUG] DescribePrivateZones request finished")
 	for _, v := range vpcList {
+		if v == nil {
+			continue
+		}
 		records = append(records, v.([]*zdns.PrivateZoneRecord)...)
 	}
 	log.Printf("[DEBUG] transfer `private zones` finished")
@@ -204,6 +252,12 @@ func convertPrivateZoneRecordsRequestFilter(filter *PrivateRecordFilter) *zdns.D
 	request.Type = &filter.RecordType
 	request.ZoneId = &filter.ZoneId
 	request.Value = &filter.RecordValue
+	if filter.RecordName != "" {
+		request.RecordName = &filter.RecordName
+	}
+	if filter.Line != "" {
+		request.Line = &filter.Line
+	}
 	return request
 
 }
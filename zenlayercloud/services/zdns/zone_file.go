@@ -0,0 +1,443 @@
+package zdns
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/common"
+	pvtdns "github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/zdns20251101"
+)
+
+// zoneFileRecord is a record parsed from or rendered to a zone file (RFC 1035 master file).
+type zoneFileRecord struct {
+	RecordName string
+	Type       string
+	Value      string
+	Ttl        int
+	Priority   int
+}
+
+// key identifies the record regardless of the ttl and MX priority, which are modifiable through the API.
+func (r zoneFileRecord) key() string {
+	return strings.ToLower(r.RecordName) + "|" + r.Type + "|" + r.Value
+}
+
+type zoneFileToken struct {
+	text   string
+	quoted bool
+}
+
+type zoneFileLine struct {
+	lineNo     int
+	blankOwner bool
+	tokens     []zoneFileToken
+}
+
+// tokenizeZoneFile splits the zone file into logical lines, joining the lines enclosed in parentheses
+// and dropping the comments.
+func tokenizeZoneFile(content string) ([]zoneFileLine, error) {
+	var lines []zoneFileLine
+	var current zoneFileLine
+	var token strings.Builder
+	inToken, inQuote, lineStart := false, false, true
+	depth, lineNo := 0, 1
+	current.lineNo = lineNo
+
+	flush := func() {
+		if inToken {
+			current.tokens = append(current.tokens, zoneFileToken{text: token.String()})
+			token.Reset()
+			inToken = false
+		}
+	}
+
+	chars := []rune(content)
+	for i := 0; i < len(chars); i++ {
+		c := chars[i]
+		if lineStart {
+			lineStart = false
+			if c == ' ' || c == '\t' {
+				current.blankOwner = true
+			}
+		}
+		if inQuote {
+			switch c {
+			case '"':
+				current.tokens = append(current.tokens, zoneFileToken{text: token.String(), quoted: true})
+				token.Reset()
+				inQuote = false
+			case '\\':
+				if i+3 < len(chars) && isDigits(string(chars[i+1:i+4])) {
+					v, _ := strconv.Atoi(string(chars[i+1 : i+4]))
+					token.WriteByte(byte(v))
+					i += 3
+				} else if i+1 < len(chars) {
+					token.WriteRune(chars[i+1])
+					i++
+				}
+			case '\n':
+				return nil, fmt.Errorf("line %d: unterminated quoted string", lineNo)
+			default:
+				token.WriteRune(c)
+			}
+			continue
+		}
+
+		switch c {
+		case ';':
+			for i+1 < len(chars) && chars[i+1] != '\n' {
+				i++
+			}
+		case '"':
+			flush()
+			inQuote = true
+		case '(':
+			flush()
+			depth++
+		case ')':
+			flush()
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("line %d: unbalanced parentheses", lineNo)
+			}
+		case '\n':
+			flush()
+			lineNo++
+			if depth > 0 {
+				continue
+			}
+			if len(current.tokens) > 0 {
+				lines = append(lines, current)
+			}
+			current = zoneFileLine{lineNo: lineNo}
+			lineStart = true
+		case ' ', '\t', '\r':
+			flush()
+		default:
+			token.WriteRune(c)
+			inToken = true
+		}
+	}
+	if inQuote {
+		return nil, fmt.Errorf("line %d: unterminated quoted string", lineNo)
+	}
+	if depth > 0 {
+		return nil, fmt.Errorf("line %d: unbalanced parentheses", lineNo)
+	}
+	flush()
+	if len(current.tokens) > 0 {
+		lines = append(lines, current)
+	}
+	return lines, nil
+}
+
+// parseZoneFile parses the zone file of the zone into records. The names are returned relative to the zone,
+// and the domain names in the record values are fully qualified without the trailing dot.
+// SOA and NS records are skipped as they are maintained by the private zone itself.
+func parseZoneFile(content string, zoneName string) ([]zoneFileRecord, error) {
+	lines, err := tokenizeZoneFile(content)
+	if err != nil {
+		return nil, err
+	}
+
+	zone := toFqdn(zoneName, ".")
+	origin := zone
+	defaultTtl := 0
+	lastOwner := ""
+	var records []zoneFileRecord
+
+	for _, line := range lines {
+		tokens := line.tokens
+		if !tokens[0].quoted && strings.HasPrefix(tokens[0].text, "$") {
+			directive := strings.ToUpper(tokens[0].text)
+			switch directive {
+			case "$ORIGIN":
+				if len(tokens) != 2 || !strings.HasSuffix(tokens[1].text, ".") {
+					return nil, fmt.Errorf("line %d: $ORIGIN requires a fully qualified domain name", line.lineNo)
+				}
+				origin = toFqdn(tokens[1].text, origin)
+			case "$TTL":
+				if len(tokens) != 2 {
+					return nil, fmt.Errorf("line %d: $TTL requires a value", line.lineNo)
+				}
+				ttl, ok := parseZoneFileTtl(tokens[1].text)
+				if !ok {
+					return nil, fmt.Errorf("line %d: invalid $TTL `%s`", line.lineNo, tokens[1].text)
+				}
+				if err := checkZoneFileTtl(ttl); err != nil {
+					return nil, fmt.Errorf("line %d: invalid $TTL: %v", line.lineNo, err)
+				}
+				defaultTtl = ttl
+			default:
+				return nil, fmt.Errorf("line %d: unsupported directive `%s`", line.lineNo, tokens[0].text)
+			}
+			continue
+		}
+
+		owner := lastOwner
+		if !line.blankOwner {
+			owner = toFqdn(tokens[0].text, origin)
+			tokens = tokens[1:]
+		}
+		if owner == "" {
+			return nil, fmt.Errorf("line %d: missing owner name", line.lineNo)
+		}
+		lastOwner = owner
+
+		ttl := defaultTtl
+		for j := 0; j < 2 && len(tokens) > 0; j++ {
+			if v, ok := parseZoneFileTtl(tokens[0].text); ok {
+				if err := checkZoneFileTtl(v); err != nil {
+					return nil, fmt.Errorf("line %d: %v", line.lineNo, err)
+				}
+				ttl = v
+			} else if class := strings.ToUpper(tokens[0].text); class == "CH" || class == "HS" || class == "CS" {
+				return nil, fmt.Errorf("line %d: unsupported class `%s`", line.lineNo, tokens[0].text)
+			} else if class != "IN" {
+				break
+			}
+			tokens = tokens[1:]
+		}
+		if len(tokens) == 0 {
+			return nil, fmt.Errorf("line %d: missing record type", line.lineNo)
+		}
+
+		recordType := strings.ToUpper(tokens[0].text)
+		rdata := tokens[1:]
+		record := zoneFileRecord{Type: recordType, Ttl: ttl}
+
+		switch recordType {
+		case "SOA", "NS":
+			continue
+		case "A", "AAAA":
+			if len(rdata) != 1 {
+				return nil, fmt.Errorf("line %d: %s record requires one address", line.lineNo, recordType)
+			}
+			ip := net.ParseIP(rdata[0].text)
+			if ip == nil || (recordType == "A") != (ip.To4() != nil) {
+				return nil, fmt.Errorf("line %d: invalid %s address `%s`", line.lineNo, recordType, rdata[0].text)
+			}
+			record.Value = ip.String()
+		case "CNAME", "PTR":
+			if len(rdata) != 1 {
+				return nil, fmt.Errorf("line %d: %s record requires one domain name", line.lineNo, recordType)
+			}
+			record.Value = strings.TrimSuffix(toFqdn(rdata[0].text, origin), ".")
+		case "MX":
+			if len(rdata) != 2 {
+				return nil, fmt.Errorf("line %d: MX record requires a preference and an exchange", line.lineNo)
+			}
+			priority, err := strconv.Atoi(rdata[0].text)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid MX preference `%s`", line.lineNo, rdata[0].text)
+			}
+			// the private zone only accepts MX priority in [1, 99]
+			if priority < 1 || priority > 99 {
+				return nil, fmt.Errorf("line %d: MX preference %d is out of range [1, 99]", line.lineNo, priority)
+			}
+			record.Priority = priority
+			record.Value = strings.TrimSuffix(toFqdn(rdata[1].text, origin), ".")
+		case "SRV":
+			if len(rdata) != 4 {
+				return nil, fmt.Errorf("line %d: SRV record requires priority, weight, port and target", line.lineNo)
+			}
+			for _, t := range rdata[:3] {
+				if !isDigits(t.text) {
+					return nil, fmt.Errorf("line %d: invalid SRV field `%s`", line.lineNo, t.text)
+				}
+			}
+			record.Value = fmt.Sprintf("%s %s %s %s", rdata[0].text, rdata[1].text, rdata[2].text,
+				strings.TrimSuffix(toFqdn(rdata[3].text, origin), "."))
+		case "TXT":
+			if len(rdata) == 0 {
+				return nil, fmt.Errorf("line %d: TXT record requires at least one string", line.lineNo)
+			}
+			var text strings.Builder
+			for _, t := range rdata {
+				text.WriteString(t.text)
+			}
+			record.Value = text.String()
+		default:
+			return nil, fmt.Errorf("line %d: unsupported record type `%s`", line.lineNo, tokens[0].text)
+		}
+
+		name, err := relativeRecordName(owner, zone)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line.lineNo, err)
+		}
+		record.RecordName = name
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// renderZoneFile renders the records of the zone in zone file format, sorted by name, type and value.
+func renderZoneFile(zoneName string, records []zoneFileRecord) string {
+	sorted := make([]zoneFileRecord, len(records))
+	copy(sorted, records)
+	sort.Slice(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if a.RecordName != b.RecordName {
+			if a.RecordName == "@" || b.RecordName == "@" {
+				return a.RecordName == "@"
+			}
+			return a.RecordName < b.RecordName
+		}
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		return a.Value < b.Value
+	})
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("$ORIGIN %s\n", toFqdn(zoneName, ".")))
+	for _, r := range sorted {
+		ttl := ""
+		if r.Ttl > 0 {
+			ttl = strconv.Itoa(r.Ttl)
+		}
+		b.WriteString(fmt.Sprintf("%s\t%s\tIN\t%s\t%s\n", r.RecordName, ttl, r.Type, renderZoneFileValue(r)))
+	}
+	return b.String()
+}
+
+func renderZoneFileValue(r zoneFileRecord) string {
+	switch r.Type {
+	case "CNAME", "PTR":
+		return toFqdn(r.Value, ".")
+	case "MX":
+		return fmt.Sprintf("%d %s", r.Priority, toFqdn(r.Value, "."))
+	case "SRV":
+		fields := strings.Fields(r.Value)
+		if len(fields) == 4 {
+			fields[3] = toFqdn(fields[3], ".")
+		}
+		return strings.Join(fields, " ")
+	case "TXT":
+		var chunks []string
+		value := r.Value
+		for {
+			chunk := value
+			if len(chunk) > 255 {
+				chunk = chunk[:255]
+			}
+			value = value[len(chunk):]
+			chunk = strings.ReplaceAll(chunk, `\`, `\\`)
+			chunks = append(chunks, `"`+strings.ReplaceAll(chunk, `"`, `\"`)+`"`)
+			if value == "" {
+				break
+			}
+		}
+		return strings.Join(chunks, " ")
+	default:
+		return r.Value
+	}
+}
+
+// toZoneFileRecord converts the private zone record into the normalized form of parseZoneFile.
+func toZoneFileRecord(record *pvtdns.PrivateZoneRecord) zoneFileRecord {
+	r := zoneFileRecord{
+		RecordName: common.ToString(record.RecordName),
+		Type:       common.ToString(record.Type),
+		Value:      common.ToString(record.Value),
+	}
+	if record.Ttl != nil {
+		r.Ttl = *record.Ttl
+	}
+	switch r.Type {
+	case "A", "AAAA":
+		if ip := net.ParseIP(r.Value); ip != nil {
+			r.Value = ip.String()
+		}
+	case "CNAME", "PTR", "MX":
+		r.Value = strings.ToLower(strings.TrimSuffix(r.Value, "."))
+	case "SRV":
+		fields := strings.Fields(r.Value)
+		if len(fields) == 4 {
+			fields[3] = strings.ToLower(strings.TrimSuffix(fields[3], "."))
+		}
+		r.Value = strings.Join(fields, " ")
+	}
+	if r.Type == "MX" && record.Priority != nil {
+		r.Priority = *record.Priority
+	}
+	return r
+}
+
+func toFqdn(name string, origin string) string {
+	name = strings.ToLower(name)
+	if name == "@" {
+		return origin
+	}
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+	if origin == "." {
+		return name + "."
+	}
+	return name + "." + origin
+}
+
+func relativeRecordName(fqdn string, zone string) (string, error) {
+	if fqdn == zone {
+		return "@", nil
+	}
+	if strings.HasSuffix(fqdn, "."+zone) {
+		return strings.TrimSuffix(fqdn, "."+zone), nil
+	}
+	return "", fmt.Errorf("name `%s` is out of zone `%s`", fqdn, zone)
+}
+
+func parseZoneFileTtl(s string) (int, bool) {
+	if s == "" {
+		return 0, false
+	}
+	if isDigits(s) {
+		v, err := strconv.Atoi(s)
+		return v, err == nil
+	}
+	// BIND style ttl, such as 1h30m
+	units := map[byte]int{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+	total, num := 0, ""
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c >= '0' && c <= '9' {
+			num += string(c)
+			continue
+		}
+		unit, ok := units[c|0x20]
+		if !ok || num == "" {
+			return 0, false
+		}
+		v, _ := strconv.Atoi(num)
+		total += v * unit
+		num = ""
+	}
+	if num != "" {
+		return 0, false
+	}
+	return total, true
+}
+
+// checkZoneFileTtl checks the ttl against the range accepted by the private zone.
+func checkZoneFileTtl(ttl int) error {
+	if ttl < 5 || ttl > 86400 {
+		return fmt.Errorf("ttl %d is out of range [5, 86400]", ttl)
+	}
+	return nil
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,236 @@
+package zdns
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/common"
+	pvtdns "github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/zdns20251101"
+)
+
+func TestParseZoneFile(t *testing.T) {
+	cases := []struct {
+		name      string
+		content   string
+		records   []zoneFileRecord
+		expectErr bool
+	}{
+		{
+			name: "parenthesised SOA and comments",
+			content: `$TTL 300
+@ IN SOA ns1.example.com. admin.example.com. ( ; the SOA is skipped
+        2024010101 ; serial
+        3600       ; refresh
+        600 86400 300 )
+@ IN NS ns1.example.com.
+www IN A 192.168.0.1 ; web server
+`,
+			records: []zoneFileRecord{
+				{RecordName: "www", Type: "A", Value: "192.168.0.1", Ttl: 300},
+			},
+		},
+		{
+			name: "blank owner continuation",
+			content: `www 60 IN A 192.168.0.1
+    60 IN A 192.168.0.2
+	IN AAAA 2001:db8::1
+`,
+			records: []zoneFileRecord{
+				{RecordName: "www", Type: "A", Value: "192.168.0.1", Ttl: 60},
+				{RecordName: "www", Type: "A", Value: "192.168.0.2", Ttl: 60},
+				{RecordName: "www", Type: "AAAA", Value: "2001:db8::1"},
+			},
+		},
+		{
+			name:      "blank owner without previous owner",
+			content:   "  IN A 192.168.0.1\n",
+			expectErr: true,
+		},
+		{
+			name: "origin and ttl",
+			content: `$TTL 1h30m
+$ORIGIN dev.example.com.
+api IN CNAME www
+$ORIGIN example.com.
+mail 600 IN MX 10 mx
+@ IN TXT "root"
+`,
+			records: []zoneFileRecord{
+				{RecordName: "api.dev", Type: "CNAME", Value: "www.dev.example.com", Ttl: 5400},
+				{RecordName: "mail", Type: "MX", Value: "mx.example.com", Ttl: 600, Priority: 10},
+				{RecordName: "@", Type: "TXT", Value: "root", Ttl: 5400},
+			},
+		},
+		{
+			name:      "relative origin",
+			content:   "$ORIGIN dev\n",
+			expectErr: true,
+		},
+		{
+			name:      "invalid ttl",
+			content:   "$TTL 1x\n",
+			expectErr: true,
+		},
+		{
+			name: "escapes and multiple strings of TXT",
+			content: `txt IN TXT "v=spf1 " "include:example.net" " \"quoted\" \\ \065\066C"
+`,
+			records: []zoneFileRecord{
+				{RecordName: "txt", Type: "TXT", Value: `v=spf1 include:example.net "quoted" \ ABC`},
+			},
+		},
+		{
+			name:      "unterminated string",
+			content:   "txt IN TXT \"abc\n",
+			expectErr: true,
+		},
+		{
+			name:      "unbalanced parentheses",
+			content:   "@ IN SOA ns1 admin ( 1 2 3 4 5\n",
+			expectErr: true,
+		},
+		{
+			name:      "out of zone owner",
+			content:   "www.example.net. IN A 192.168.0.1\n",
+			expectErr: true,
+		},
+		{
+			name:      "out of zone origin",
+			content:   "$ORIGIN example.net.\nwww IN A 192.168.0.1\n",
+			expectErr: true,
+		},
+		{
+			name: "srv and ptr",
+			content: `_sip._tcp IN SRV 10 60 5060 sip
+1.0 IN PTR host.example.com.
+`,
+			records: []zoneFileRecord{
+				{RecordName: "_sip._tcp", Type: "SRV", Value: "10 60 5060 sip.example.com"},
+				{RecordName: "1.0", Type: "PTR", Value: "host.example.com"},
+			},
+		},
+		{
+			name:      "mx preference out of range",
+			content:   "@ IN MX 100 mx\n",
+			expectErr: true,
+		},
+		{
+			name:      "mx preference zero",
+			content:   "@ IN MX 0 mx\n",
+			expectErr: true,
+		},
+		{
+			name:      "invalid address",
+			content:   "www IN A 2001:db8::1\n",
+			expectErr: true,
+		},
+		{
+			name:      "ttl out of range",
+			content:   "www 1 IN A 192.168.0.1\n",
+			expectErr: true,
+		},
+		{
+			name:      "default ttl out of range",
+			content:   "$TTL 2d\nwww IN A 192.168.0.1\n",
+			expectErr: true,
+		},
+		{
+			name:      "unsupported class",
+			content:   "www CH A 192.168.0.1\n",
+			expectErr: true,
+		},
+		{
+			name:      "unsupported type",
+			content:   "www IN HINFO cpu os\n",
+			expectErr: true,
+		},
+	}
+	for _, c := range cases {
+		records, err := parseZoneFile(c.content, "example.com")
+		if (err != nil) != c.expectErr {
+			t.Errorf("%s: expect error %v, got %v", c.name, c.expectErr, err)
+			continue
+		}
+		if err == nil && !reflect.DeepEqual(records, c.records) {
+			t.Errorf("%s: expect records %+v, got %+v", c.name, c.records, records)
+		}
+	}
+}
+
+func TestParseZoneFileTtl(t *testing.T) {
+	cases := map[string]int{
+		"300":   300,
+		"1h30m": 5400,
+		"1D":    86400,
+		"1w2d":  777600,
+	}
+	for s, expect := range cases {
+		if v, ok := parseZoneFileTtl(s); !ok || v != expect {
+			t.Errorf("%s: expect %d, got %d, %v", s, expect, v, ok)
+		}
+	}
+	for _, s := range []string{"", "h", "1x", "30m1", "IN", "MX"} {
+		if _, ok := parseZoneFileTtl(s); ok {
+			t.Errorf("%s: expect invalid ttl", s)
+		}
+	}
+}
+
+func TestToZoneFileRecord(t *testing.T) {
+	cases := []struct {
+		record *pvtdns.PrivateZoneRecord
+		expect zoneFileRecord
+	}{
+		{
+			&pvtdns.PrivateZoneRecord{RecordName: common.String("www"), Type: common.String("AAAA"), Value: common.String("2001:0DB8:0000::0001"), Ttl: common.Integer(60)},
+			zoneFileRecord{RecordName: "www", Type: "AAAA", Value: "2001:db8::1", Ttl: 60},
+		},
+		{
+			&pvtdns.PrivateZoneRecord{RecordName: common.String("www"), Type: common.String("A"), Value: common.String("::ffff:192.168.0.1"), Ttl: common.Integer(60)},
+			zoneFileRecord{RecordName: "www", Type: "A", Value: "192.168.0.1", Ttl: 60},
+		},
+		{
+			&pvtdns.PrivateZoneRecord{RecordName: common.String("@"), Type: common.String("MX"), Value: common.String("MX.Example.com."), Ttl: common.Integer(600), Priority: common.Integer(10)},
+			zoneFileRecord{RecordName: "@", Type: "MX", Value: "mx.example.com", Ttl: 600, Priority: 10},
+		},
+	}
+	for _, c := range cases {
+		if r := toZoneFileRecord(c.record); !reflect.DeepEqual(r, c.expect) {
+			t.Errorf("expect %+v, got %+v", c.expect, r)
+		}
+	}
+}
+
+func TestRenderZoneFileRoundTrip(t *testing.T) {
+	records := []zoneFileRecord{
+		{RecordName: "www", Type: "A", Value: "192.168.0.1", Ttl: 60},
+		{RecordName: "@", Type: "MX", Value: "mx.example.com", Ttl: 600, Priority: 10},
+		{RecordName: "api", Type: "CNAME", Value: "www.example.com"},
+		{RecordName: "_sip._tcp", Type: "SRV", Value: "10 60 5060 sip.example.com", Ttl: 300},
+		{RecordName: "1.0", Type: "PTR", Value: "host.example.com", Ttl: 300},
+		{RecordName: "txt", Type: "TXT", Value: `say "hi" \ ` + strings.Repeat("a", 300), Ttl: 300},
+		{RecordName: "www", Type: "AAAA", Value: "2001:db8::1", Ttl: 60},
+	}
+
+	content := renderZoneFile("example.com", records)
+	parsed, err := parseZoneFile(content, "example.com")
+	if err != nil {
+		t.Fatalf("fail to parse the rendered zone file: %v\n%s", err, content)
+	}
+	if len(parsed) != len(records) {
+		t.Fatalf("expect %d records, got %d:\n%s", len(records), len(parsed), content)
+	}
+	expect := make(map[string]zoneFileRecord, len(records))
+	for _, r := range records {
+		expect[r.key()] = r
+	}
+	for _, r := range parsed {
+		if e, ok := expect[r.key()]; !ok || !reflect.DeepEqual(e, r) {
+			t.Errorf("unexpected record after round trip: %+v", r)
+		}
+	}
+	if parsed[0].RecordName != "@" {
+		t.Errorf("expect records of the zone apex first, got %+v", parsed[0])
+	}
+}
@@ -0,0 +1,251 @@
+package zec
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/common"
+	"github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/connectivity"
+	common2 "github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/common"
+	zec2 "github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/zec20250901"
+)
+
+func DataSourceZenlayerCloudDDoSAttackEvents() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceZenlayerCloudDDoSAttackEventsRead,
+		Schema: map[string]*schema.Schema{
+			"start_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "The start time of the time range. The format is `yyyy-MM-ddTHH:mm:ssZ`.",
+			},
+			"end_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "The end time of the time range. The format is `yyyy-MM-ddTHH:mm:ssZ`.",
+			},
+			"ip_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter by the attacked IP address.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter by the attack status.",
+			},
+			"result": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of DDoS attack events.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"event_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the attack event.",
+						},
+						"ip_address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The attacked IP address.",
+						},
+						"region_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the region where the attack happened.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The attack status.",
+						},
+						"protecting": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the IP is being protected.",
+						},
+						"attack_types": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The attack types.",
+						},
+						"start_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time when the attack started.",
+						},
+						"end_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time when the attack ended.",
+						},
+						"end_blackhole_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time when the IP is released from blackhole.",
+						},
+						"attack_bandwidth_max": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "Peak bandwidth of the attack. Unit: bps.",
+						},
+						"attack_package_max": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "Peak packet rate of the attack. Unit: pps.",
+						},
+						"protected_bandwidth_max": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "Peak bandwidth mitigated by the protection. Unit: bps.",
+						},
+						"protected_package_max": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "Peak packet rate mitigated by the protection. Unit: pps.",
+						},
+					},
+				},
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results to a local file.",
+			},
+		},
+	}
+}
+
+func dataSourceZenlayerCloudDDoSAttackEventsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer common.LogElapsed(ctx, "data_source.zenlayercloud_ddos_attack_events.read")()
+
+	zecService := ZecService{client: meta.(*connectivity.ZenlayerCloudClient)}
+
+	filter := &DDoSAttackEventFilter{
+		StartTime: d.Get("start_time").(string),
+		EndTime:   d.Get("end_time").(string),
+	}
+	if v, ok := d.GetOk("ip_address"); ok {
+		filter.IpAddress = v.(string)
+	}
+	if v, ok := d.GetOk("status"); ok {
+		filter.Status = v.(string)
+	}
+
+	var events []*zec2.AttackEventInfo
+	err := resource.RetryContext(ctx, common.ReadRetryTimeout, func() *resource.RetryError {
+		result, e := zecService.DescribeDDoSAttackEvents(ctx, filter)
+		if e != nil {
+			return common.RetryError(ctx, e, common.InternalServerError)
+		}
+		events = result
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// attack types and mitigated peaks are only available in the event detail
+	details := make([]*zec2.DescribeDDosEventDetailResponseParams, len(events))
+	g := common.NewGoRoutine(10)
+	wg := sync.WaitGroup{}
+	var firstErr error
+	var mu sync.Mutex
+	for i, event := range events {
+		if event.EventId == nil || event.RegionId == nil {
+			continue
+		}
+		wg.Add(1)
+		index := i
+		eventId, regionId := *event.EventId, *event.RegionId
+		g.Run(func() {
+			defer wg.Done()
+			var detail *zec2.DescribeDDosEventDetailResponseParams
+			e := resource.RetryContext(ctx, common.ReadRetryTimeout, func() *resource.RetryError {
+				var errRet error
+				detail, errRet = zecService.DescribeDDoSAttackEventDetail(ctx, eventId, regionId)
+				if errRet != nil {
+					return common.RetryError(ctx, errRet, common.InternalServerError)
+				}
+				return nil
+			})
+			mu.Lock()
+			defer mu.Unlock()
+			if e != nil {
+				if firstErr == nil {
+					firstErr = e
+				}
+				return
+			}
+			details[index] = detail
+		})
+	}
+	wg.Wait()
+	if firstErr != nil {
+		return diag.FromErr(firstErr)
+	}
+
+	ids := make([]string, 0, len(events)+2)
+	ids = append(ids, filter.StartTime, filter.EndTime)
+	eventList := make([]map[string]interface{}, 0, len(events))
+	for i, event := range events {
+		mapping := map[string]interface{}{
+			"event_id":             common2.ToString(event.EventId),
+			"ip_address":           common2.ToString(event.IpAddress),
+			"region_id":            common2.ToString(event.RegionId),
+			"status":               common2.ToString(event.Status),
+			"protecting":           common2.ToBool(event.Protecting),
+			"start_time":           common2.ToString(event.StartTime),
+			"end_time":             common2.ToString(event.EndTime),
+			"end_blackhole_time":   common2.ToString(event.EndBlackholeTime),
+			"attack_bandwidth_max": floatValue(event.AttackBandwidthMax),
+			"attack_package_max":   floatValue(event.AttackPackageMax),
+			"attack_types":         []string{},
+		}
+		if detail := details[i]; detail != nil {
+			mapping["attack_types"] = splitDDoSAttackTypes(common2.ToString(detail.Type))
+			mapping["protected_bandwidth_max"] = floatValue(detail.ProtectedBandwidthMax)
+			mapping["protected_package_max"] = floatValue(detail.ProtectedPackageMax)
+		}
+		eventList = append(eventList, mapping)
+		ids = append(ids, common2.ToString(event.EventId))
+	}
+
+	d.SetId(common.DataResourceIdHash(ids))
+	_ = d.Set("result", eventList)
+
+	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
+		if err := common.WriteToFile(output.(string), eventList); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// splitDDoSAttackTypes splits the comma separated attack types of the event detail.
+func splitDDoSAttackTypes(attackType string) []string {
+	types := make([]string, 0)
+	for _, t := range strings.Split(attackType, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			types = append(types, t)
+		}
+	}
+	return types
+}
+
+func floatValue(v *float64) float64 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
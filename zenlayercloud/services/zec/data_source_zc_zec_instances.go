@@ -340,4 +340,5 @@ type ZecInstancesFilter struct {
 	ResourceGroupId string
 	Ipv4            string
 	Ipv6            string
+	Tags            map[string]string
 }
@@ -27,6 +27,7 @@ func ResourceZenlayerCloudZecDisk() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: common2.GrowOnlyFieldValidFunc("disk_size", "last_resize_time"),
 		Schema: map[string]*schema.Schema{
 			"availability_zone": {
 				Type:        schema.TypeString,
@@ -45,7 +46,7 @@ func ResourceZenlayerCloudZecDisk() *schema.Resource {
 				Type:         schema.TypeInt,
 				Required:     true,
 				ValidateFunc: validation.IntAtLeast(20),
-				Description:  "The size of disk. Unit: GiB. The minimum value is 20 GiB. When resize the disk, the new size must be greater than the former value.",
+				Description:  "The size of disk. Unit: GiB. The minimum value is 20 GiB. When resize the disk, the new size must be greater than the former value. The disk can be resized while attached, the filesystem in the guest needs to be extended after that.",
 			},
 			"disk_category": {
 				Type:        schema.TypeString,
@@ -54,6 +55,20 @@ func ResourceZenlayerCloudZecDisk() *schema.Resource {
 				Default:     "Standard NVMe SSD",
 				Description: "The category of disk. Default is `Standard NVMe SSD`.",
 			},
+			"snapshot_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"source_disk_id"},
+				Description:   "The ID of the data disk snapshot to create the disk from. The `disk_size` must not be less than the size of the snapshot's source disk.",
+			},
+			"source_disk_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"snapshot_id"},
+				Description:   "The ID of the data disk to clone. A temporary snapshot of the source disk is created, used to create this disk and deleted afterwards. The source disk must locate at the same `availability_zone`, and the `disk_size` must not be less than the size of the source disk.",
+			},
 			"disk_type": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -86,6 +101,11 @@ func ResourceZenlayerCloudZecDisk() *schema.Resource {
 				Computed:    true,
 				Description: "Create time of the disk.",
 			},
+			"last_resize_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time when the last resize of the disk completed, in RFC 3339 format. Empty if the disk has not been resized by Terraform. Can be used to trigger extending the filesystem in the guest.",
+			},
 		},
 	}
 }
@@ -218,9 +238,9 @@ func resourceZenlayerCloudVmDiskUpdate(ctx context.Context, d *schema.ResourceDa
 	}
 	d.Partial(true)
 	if d.HasChange("disk_size") {
-
+		diskSize := d.Get("disk_size").(int)
 		err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate)-time.Minute, func() *resource.RetryError {
-			err := zecService.ResizeDisk(ctx, diskId, d.Get("disk_size").(int))
+			err := zecService.ResizeDisk(ctx, diskId, diskSize)
 			if err != nil {
 				return common2.RetryError(ctx, err, common2.InternalServerError, common.NetworkError)
 			}
@@ -230,6 +250,12 @@ func resourceZenlayerCloudVmDiskUpdate(ctx context.Context, d *schema.ResourceDa
 		if err != nil {
 			return diag.FromErr(err)
 		}
+
+		err = zecService.WaitDiskResized(ctx, diskId, diskSize, d.Timeout(schema.TimeoutUpdate)-time.Minute)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error waiting for disk (%s) to be resized: %w", diskId, err))
+		}
+		_ = d.Set("last_resize_time", time.Now().UTC().Format(time.RFC3339))
 	}
 
 	return resourceZenlayerCloudVmDiskRead(ctx, d, meta)
@@ -263,6 +289,20 @@ func resourceZenlayerCloudZecDiskCreate(ctx context.Context, d *schema.ResourceD
 		request.ResourceGroupId = common.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("snapshot_id"); ok {
+		request.SnapshotId = common.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("source_disk_id"); ok {
+		snapshotId, err := createZecDiskCloneSnapshot(ctx, vmService, d, v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		// the snapshot is only needed until the disk has been created
+		defer deleteZecDiskCloneSnapshot(ctx, vmService, d, snapshotId)
+		request.SnapshotId = common.String(snapshotId)
+	}
+
 	diskId := ""
 
 	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
@@ -304,6 +344,58 @@ func resourceZenlayerCloudZecDiskCreate(ctx context.Context, d *schema.ResourceD
 	return resourceZenlayerCloudVmDiskRead(ctx, d, meta)
 }
 
+func createZecDiskCloneSnapshot(ctx context.Context, zecService *ZecService, d *schema.ResourceData, sourceDiskId string) (string, error) {
+	sourceDisk, err := zecService.DescribeDiskById(ctx, sourceDiskId)
+	if err != nil {
+		return "", err
+	}
+	if sourceDisk == nil {
+		return "", fmt.Errorf("source disk %s is not exist", sourceDiskId)
+	}
+	if zoneId := d.Get("availability_zone").(string); common.ToString(sourceDisk.ZoneId) != zoneId {
+		return "", fmt.Errorf("source disk %s locates at %s, which is different from the availability zone %s of the disk", sourceDiskId, common.ToString(sourceDisk.ZoneId), zoneId)
+	}
+	if common.ToString(sourceDisk.DiskType) != "DATA" {
+		return "", fmt.Errorf("source disk %s is not a data disk", sourceDiskId)
+	}
+	if sourceDisk.DiskSize != nil && d.Get("disk_size").(int) < *sourceDisk.DiskSize {
+		return "", fmt.Errorf("disk_size %d is less than the size %d of source disk %s", d.Get("disk_size").(int), *sourceDisk.DiskSize, sourceDiskId)
+	}
+
+	snapshotId := ""
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		snapshotId, err = zecService.CreateSnapshot(ctx, sourceDiskId, fmt.Sprintf("Terraform-Clone-%s", sourceDiskId))
+		if err != nil {
+			return common2.RetryError(ctx, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if err = zecService.WaitSnapshotAvailable(ctx, snapshotId, d.Timeout(schema.TimeoutCreate)-time.Minute); err != nil {
+		deleteZecDiskCloneSnapshot(ctx, zecService, d, snapshotId)
+		return "", fmt.Errorf("error waiting for snapshot (%s) of source disk %s to be created: %v", snapshotId, sourceDiskId, err)
+	}
+	return snapshotId, nil
+}
+
+func deleteZecDiskCloneSnapshot(ctx context.Context, zecService *ZecService, d *schema.ResourceData, snapshotId string) {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate)-time.Minute, func() *resource.RetryError {
+		if errRet := zecService.DeleteSnapshot(ctx, snapshotId); errRet != nil {
+			return common2.RetryError(ctx, errRet, common2.InternalServerError)
+		}
+		return nil
+	})
+	if err != nil {
+		tflog.Warn(ctx, "Fail to delete the temporary snapshot of clone disk.", map[string]interface{}{
+			"snapshotId": snapshotId,
+			"err":        err.Error(),
+		})
+	}
+}
+
 func resourceZenlayerCloudVmDiskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
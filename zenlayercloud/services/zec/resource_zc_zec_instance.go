@@ -28,6 +28,7 @@ func ResourceZenlayerCloudZecInstance() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: common2.GrowOnlyFieldValidFunc("system_disk_size", "system_disk_last_resize_time"),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(common2.VmCreateTimeout),
 			Update: schema.DefaultTimeout(common2.VmUpdateTimeout),
@@ -150,8 +151,12 @@ func ResourceZenlayerCloudZecInstance() *schema.Resource {
 			"system_disk_size": {
 				Type:        schema.TypeInt,
 				Required:    true,
-				ForceNew:    true,
-				Description: "Size of the system disk. unit is GiB. If modified, the ZEC instance may force stop.",
+				Description: "Size of the system disk. unit is GiB. The size can only be increased, the filesystem in the guest needs to be extended after that.",
+			},
+			"system_disk_last_resize_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time when the last resize of the system disk completed, in RFC 3339 format. Empty if the system disk has not been resized by Terraform. Can be used to trigger extending the filesystem in the guest.",
 			},
 			"time_zone": {
 				Type:        schema.TypeString,
@@ -593,6 +598,27 @@ func resourceZenlayerCloudZecInstanceUpdate(ctx context.Context, d *schema.Resou
 		}
 	}
 
+	if d.HasChange("system_disk_size") {
+		systemDiskId := d.Get("system_disk_id").(string)
+		diskSize := d.Get("system_disk_size").(int)
+		err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate)-time.Minute, func() *resource.RetryError {
+			errRet := zecService.ResizeDisk(ctx, systemDiskId, diskSize)
+			if errRet != nil {
+				return common2.RetryError(ctx, errRet, common2.InternalServerError, common.NetworkError)
+			}
+			return nil
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		err = zecService.WaitDiskResized(ctx, systemDiskId, diskSize, d.Timeout(schema.TimeoutUpdate)-time.Minute)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error waiting for system disk (%s) to be resized: %w", systemDiskId, err))
+		}
+		_ = d.Set("system_disk_last_resize_time", time.Now().UTC().Format(time.RFC3339))
+	}
+
 	if d.HasChange("tags") {
 		zrmService := zrm.NewZrmService(meta.(*connectivity.ZenlayerCloudClient))
 		err := zrmService.ModifyResourceTags(ctx, d, instanceId)
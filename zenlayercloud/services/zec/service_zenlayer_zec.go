@@ -19,6 +19,7 @@ import (
 	"math"
 	"net"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/common"
@@ -28,6 +29,10 @@ import (
 	zec2 "github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/zec20250901"
 )
 
+func NewZecService(client *connectivity.ZenlayerCloudClient) ZecService {
+	return ZecService{client: client}
+}
+
 type ZecService struct {
 	client *connectivity.ZenlayerCloudClient
 }
@@ -497,6 +502,36 @@ func (s *ZecService) DiskStateRefreshFunc(ctx context.Context, diskId string, fa
 	}
 }
 
+// WaitDiskResized waits until the disk is settled with the expected size after ResizeDisk.
+func (s *ZecService) WaitDiskResized(ctx context.Context, diskId string, diskSize int, timeout time.Duration) error {
+	refresh := s.DiskStateRefreshFunc(ctx, diskId, []string{ZecDiskStatusFaileld})
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ZecDiskStatusResizing},
+		Target: []string{
+			ZecDiskStatusInUse,
+			ZecDiskStatusAvailable,
+		},
+		Refresh: func() (interface{}, string, error) {
+			object, status, err := refresh()
+			if err != nil || object == nil {
+				return object, status, err
+			}
+			// the status may not be changed yet right after ResizeDisk, and the disk may be attaching or detaching
+			disk := object.(*zec2.DiskInfo)
+			if disk.DiskSize == nil || *disk.DiskSize < diskSize || (status != ZecDiskStatusInUse && status != ZecDiskStatusAvailable) {
+				return object, ZecDiskStatusResizing, nil
+			}
+			return object, status, nil
+		},
+		Timeout:        timeout,
+		Delay:          3 * time.Second,
+		MinTimeout:     5 * time.Second,
+		NotFoundChecks: 3,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
 func (s *ZecService) DescribeBoardGateways(filter *BoarderGatewayFilter) (zbgs []*zec2.ZbgInfo, err error) {
 	request := convertZbgRequestFilter(filter)
 
@@ -694,6 +729,60 @@ func (s *ZecService) DescribeDDoSPoliciesByFilter(ctx context.Context, filter *D
 	return policies, nil
 }
 
+// DDoSAttackEventFilter 查询DDoS攻击事件的过滤条件
+type DDoSAttackEventFilter struct {
+	IpAddress string
+	Status    string
+	StartTime string
+	EndTime   string
+}
+
+func (s *ZecService) DescribeDDoSAttackEvents(ctx context.Context, filter *DDoSAttackEventFilter) (events []*zec2.AttackEventInfo, err error) {
+	var limit = 100
+	for pageNum := 1; ; pageNum++ {
+		request := zec2.NewDescribeDDosAllEventListRequest()
+		if filter.IpAddress != "" {
+			request.IpAddress = &filter.IpAddress
+		}
+		if filter.Status != "" {
+			request.Status = &filter.Status
+		}
+		request.StartTime = &filter.StartTime
+		request.EndTime = &filter.EndTime
+		request.PageSize = &limit
+		request.PageNum = common2.Integer(pageNum)
+
+		response, err := s.client.WithZec2Client().DescribeDDosAllEventList(request)
+		common.LogApiRequest(ctx, "DescribeDDosAllEventList", request, response, err)
+		if err != nil {
+			return nil, err
+		}
+		if response == nil || response.Response == nil || len(response.Response.DataSet) == 0 {
+			return events, nil
+		}
+		events = append(events, response.Response.DataSet...)
+		if response.Response.TotalCount == nil || len(events) >= *response.Response.TotalCount {
+			return events, nil
+		}
+	}
+}
+
+func (s *ZecService) DescribeDDoSAttackEventDetail(ctx context.Context, eventId string, regionId string) (*zec2.DescribeDDosEventDetailResponseParams, error) {
+	request := zec2.NewDescribeDDosEventDetailRequest()
+	request.EventId = &eventId
+	request.RegionId = &regionId
+
+	response, err := s.client.WithZec2Client().DescribeDDosEventDetail(request)
+	defer common.LogApiRequest(ctx, "DescribeDDosEventDetail", request, response, err)
+	if err != nil {
+		return nil, err
+	}
+	if response == nil || response.Response == nil {
+		return nil, nil
+	}
+	return response.Response, nil
+}
+
 func (s *ZecService) AttachDDoSPolicy(ctx context.Context, policyId string, ipv4Ids []string) error {
 	request := zec2.NewAttachToPolicyRequest()
 	request.PolicyId = &policyId
@@ -1373,6 +1462,37 @@ func (s *ZecService) DeleteSnapshot(ctx context.Context, snapshotId string) erro
 	return nil
 }
 
+func (s *ZecService) CreateSnapshot(ctx context.Context, diskId string, snapshotName string) (string, error) {
+	request := zec.NewCreateSnapshotRequest()
+	request.DiskId = common2.String(diskId)
+	request.SnapshotName = common2.String(snapshotName)
+	response, err := s.client.WithZecClient().CreateSnapshot(request)
+	defer common.LogApiRequest(ctx, "CreateSnapshot", request, response, err)
+
+	if err != nil {
+		return "", err
+	}
+	if response.Response.SnapshotId == nil {
+		return "", fmt.Errorf("snapshot id is nil")
+	}
+	return *response.Response.SnapshotId, nil
+}
+
+// WaitSnapshotAvailable waits until the snapshot leaves the CREATING status.
+func (s *ZecService) WaitSnapshotAvailable(ctx context.Context, snapshotId string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:        []string{SnapshotCreating},
+		Target:         []string{SnapshotAvailable},
+		Refresh:        s.SnapshotStateRefreshFunc(ctx, snapshotId, []string{SnapshotFailed}),
+		Timeout:        timeout,
+		Delay:          3 * time.Second,
+		MinTimeout:     5 * time.Second,
+		NotFoundChecks: 3,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
 func (s *ZecService) SnapshotStateRefreshFunc(ctx context.Context, snapshotId string, failStates []string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeSnapshotById(ctx, snapshotId)
@@ -1997,6 +2117,12 @@ func convertInstanceRequestFilter(filter *ZecInstancesFilter) *zec2.DescribeInst
 	if filter.ImageId != "" {
 		request.ImageId = common2.String(filter.ImageId)
 	}
+	for k, v := range filter.Tags {
+		request.Tags = append(request.Tags, &zec2.Tag{
+			Key:   common2.String(k),
+			Value: common2.String(v),
+		})
+	}
 	return request
 }
 
@@ -0,0 +1,186 @@
+package zlb
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	common2 "github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/common"
+	"github.com/zenlayer/terraform-provider-zenlayercloud/zenlayercloud/connectivity"
+	"github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/common"
+	zlb "github.com/zenlayer/zenlayercloud-sdk-go/zenlayercloud/zlb20250401"
+	"time"
+)
+
+func DataSourceZenlayerCloudZlbListenerMetrics() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceZenlayerCloudZlbListenerMetricsRead,
+
+		Schema: map[string]*schema.Schema{
+			"zlb_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of load balancer to be queried.",
+			},
+			"listener_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of listener to be queried. If not set, the metrics of the whole load balancer are returned.",
+			},
+			"metric_types": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Metric types to be queried, such as connection, bandwidth and packet metrics. Refer to the `DescribeLoadBalancerMonitorData` API for the valid values.",
+			},
+			"start_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "The start time of the time window. The format is `yyyy-MM-ddTHH:mm:ssZ`.",
+			},
+			"end_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "The end time of the time window. The format is `yyyy-MM-ddTHH:mm:ssZ`.",
+			},
+			"step": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntInSlice([]int{1, 5}),
+				Description:  "Interval between data points. Measured in minute. Valid values: `1`, `5`.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+			// Computed value
+			"metrics": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "An information list of metrics. Each element contains the following attributes:",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metric_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The metric type.",
+						},
+						"max_value": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The maximum value of data points in the time window.",
+						},
+						"min_value": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The minimum value of data points in the time window.",
+						},
+						"avg_value": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The average value of data points in the time window.",
+						},
+						"data_points": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Data points of the metric.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"time": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Time of the data point.",
+									},
+									"value": {
+										Type:        schema.TypeFloat,
+										Computed:    true,
+										Description: "Value of the data point.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceZenlayerCloudZlbListenerMetricsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer common2.LogElapsed(ctx, "data_source.zenlayercloud_zlb_listener_metrics.read")()
+
+	zlbService := ZlbService{
+		client: meta.(*connectivity.ZenlayerCloudClient),
+	}
+
+	zlbId := d.Get("zlb_id").(string)
+	listenerId := d.Get("listener_id").(string)
+	startTime := d.Get("start_time").(string)
+	endTime := d.Get("end_time").(string)
+
+	metricTypes := common2.ToStringList(d.Get("metric_types").(*schema.Set).List())
+	metricList := make([]map[string]interface{}, 0, len(metricTypes))
+
+	for _, metricType := range metricTypes {
+		request := zlb.NewDescribeLoadBalancerMonitorDataRequest()
+		request.LoadBalancerId = common.String(zlbId)
+		if listenerId != "" {
+			request.ListenerId = common.String(listenerId)
+		}
+		request.MetricType = common.String(metricType)
+		request.StartTime = common.String(startTime)
+		request.EndTime = common.String(endTime)
+		if v, ok := d.GetOk("step"); ok {
+			request.Step = common.Integer(v.(int))
+		}
+
+		var monitorData *zlb.DescribeLoadBalancerMonitorDataResponseParams
+		err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutRead)-time.Minute, func() *resource.RetryError {
+			var e error
+			monitorData, e = zlbService.DescribeLoadBalancerMonitorData(ctx, request)
+			if e != nil {
+				return common2.RetryError(ctx, e, common2.InternalServerError)
+			}
+			return nil
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		dataPoints := make([]map[string]interface{}, 0, len(monitorData.Metrics))
+		for _, metric := range monitorData.Metrics {
+			dataPoints = append(dataPoints, map[string]interface{}{
+				"time":  metric.Time,
+				"value": metric.Value,
+			})
+		}
+
+		metricList = append(metricList, map[string]interface{}{
+			"metric_type": metricType,
+			"max_value":   monitorData.MaxValue,
+			"min_value":   monitorData.MinValue,
+			"avg_value":   monitorData.AvgValue,
+			"data_points": dataPoints,
+		})
+	}
+
+	d.SetId(common2.DataResourceIdHash(append([]string{zlbId, listenerId, startTime, endTime}, metricTypes...)))
+	err := d.Set("metrics", metricList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if err := common2.WriteToFile(output.(string), metricList); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
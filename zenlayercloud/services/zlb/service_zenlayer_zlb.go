@@ -157,6 +157,15 @@ func (s *ZlbService) UnbindSecurityGroupFromLoadBalancers(ctx context.Context, z
 	return err
 }
 
+func (s *ZlbService) DescribeLoadBalancerMonitorData(ctx context.Context, request *zlb.DescribeLoadBalancerMonitorDataRequest) (*zlb.DescribeLoadBalancerMonitorDataResponseParams, error) {
+	response, err := s.client.WithZlbClient().DescribeLoadBalancerMonitorData(request)
+	defer common.LogApiRequest(ctx, "DescribeLoadBalancerMonitorData", request, response, err)
+	if err != nil {
+		return nil, err
+	}
+	return response.Response, nil
+}
+
 func convertLbInstancesRequestFilter(filter *LbInstanceFilter) *zlb.DescribeLoadBalancersRequest {
 	request := zlb.NewDescribeLoadBalancersRequest()
 	request.VpcId = &filter.VpcId